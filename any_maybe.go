@@ -0,0 +1,83 @@
+package maybe
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// AnyMaybe is implemented by every Maybe[T], so that reflection-based code
+// can inspect a Maybe without knowing T.
+type AnyMaybe interface {
+	IsSome() bool
+	IsNone() bool
+	// AnyValue returns the value boxed in an any, and whether it is present.
+	AnyValue() (any, bool)
+	// ElemType returns the reflect.Type of T.
+	ElemType() reflect.Type
+}
+
+// AnyMaybeSetter is implemented by every *Maybe[T], so that reflection-based
+// code can populate a Maybe without knowing T.
+type AnyMaybeSetter interface {
+	AnyMaybe
+	// SetAny sets the Maybe to Some(v). v must be a T, or nil when T is a nilable type.
+	SetAny(v any) error
+	// Clear sets the Maybe to None.
+	Clear()
+}
+
+var maybePkgPath = reflect.TypeFor[Maybe[struct{}]]().PkgPath()
+
+func (m Maybe[T]) AnyValue() (any, bool) {
+	if !m.hasValue {
+		return nil, false
+	}
+	return m.value, true
+}
+
+func (m Maybe[T]) ElemType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (m *Maybe[T]) SetAny(v any) error {
+	if value, ok := v.(T); ok {
+		*m = Some(value)
+		return nil
+	}
+	if v == nil {
+		switch reflect.TypeFor[T]().Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			var zero T
+			*m = Some(zero)
+			return nil
+		}
+	}
+	return fmt.Errorf("maybe: cannot set Maybe[%s] from %T", reflect.TypeFor[T](), v)
+}
+
+func (m *Maybe[T]) Clear() {
+	*m = None[T]()
+}
+
+// IsMaybeType reports whether t is an instantiation of Maybe. Wrappers that
+// embed a Maybe, such as XMLNillable and EmptyAsValue, are not, since they
+// decode differently.
+func IsMaybeType(t reflect.Type) bool {
+	// The wrappers get the AnyMaybe methods by promotion, so the generic
+	// type's name tells them apart.
+	return t != nil &&
+		t.Kind() == reflect.Struct &&
+		t.PkgPath() == maybePkgPath &&
+		strings.HasPrefix(t.Name(), "Maybe[") &&
+		t.Implements(reflect.TypeFor[AnyMaybe]()) &&
+		reflect.PointerTo(t).Implements(reflect.TypeFor[AnyMaybeSetter]())
+}
+
+// MaybeElem returns the element type T of a Maybe[T] type, and false if t is not a Maybe.
+func MaybeElem(t reflect.Type) (reflect.Type, bool) {
+	if !IsMaybeType(t) {
+		return nil, false
+	}
+	return reflect.Zero(t).Interface().(AnyMaybe).ElemType(), true
+}
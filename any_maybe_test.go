@@ -0,0 +1,119 @@
+package maybe
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var (
+	_ AnyMaybe       = Maybe[int]{}
+	_ AnyMaybeSetter = (*Maybe[int])(nil)
+)
+
+func TestAnyValue(t *testing.T) {
+	var m AnyMaybe = Some(42)
+	if v, ok := m.AnyValue(); !ok || v != 42 {
+		t.Errorf("Expected (42, true), got (%v, %v)", v, ok)
+	}
+	m = None[int]()
+	if v, ok := m.AnyValue(); ok || v != nil {
+		t.Errorf("Expected (nil, false), got (%v, %v)", v, ok)
+	}
+}
+
+func TestElemType(t *testing.T) {
+	if got := None[int]().ElemType(); got != reflect.TypeFor[int]() {
+		t.Errorf("Expected int, got %v", got)
+	}
+	if got := None[error]().ElemType(); got != reflect.TypeFor[error]() {
+		t.Errorf("Expected error, got %v", got)
+	}
+}
+
+func TestSetAny(t *testing.T) {
+	t.Run("matching type", func(t *testing.T) {
+		var m Maybe[int]
+		var setter AnyMaybeSetter = &m
+		if err := setter.SetAny(7); err != nil {
+			t.Fatalf("SetAny failed: %v", err)
+		}
+		if m != Some(7) {
+			t.Errorf("Expected Some(7), got %v", m)
+		}
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		var m Maybe[int]
+		if err := m.SetAny("7"); err == nil {
+			t.Error("Expected error setting string into Maybe[int]")
+		}
+		if err := m.SetAny(nil); err == nil {
+			t.Error("Expected error setting nil into Maybe[int]")
+		}
+		if m.IsSome() {
+			t.Errorf("Expected Maybe to be unchanged, got %v", m)
+		}
+	})
+
+	t.Run("nil for nilable types", func(t *testing.T) {
+		var p Maybe[*int]
+		if err := p.SetAny(nil); err != nil {
+			t.Fatalf("SetAny failed: %v", err)
+		}
+		if v, err := p.Unwrap(); err != nil || v != nil {
+			t.Errorf("Expected Some(nil), got %v", p)
+		}
+
+		var e Maybe[error]
+		if err := e.SetAny(nil); err != nil {
+			t.Fatalf("SetAny failed: %v", err)
+		}
+		if err := e.SetAny(errTest); err != nil || !errors.Is(e.UnwrapUnsafe(), errTest) {
+			t.Errorf("Expected Some(test error), got %v", e)
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		m := Some(1)
+		m.Clear()
+		if m.IsSome() {
+			t.Errorf("Expected None, got %v", m)
+		}
+	})
+}
+
+func TestIsMaybeType(t *testing.T) {
+	type notMaybe struct{ value int }
+	tests := []struct {
+		t        reflect.Type
+		expected bool
+	}{
+		{reflect.TypeFor[Maybe[int]](), true},
+		{reflect.TypeFor[Maybe[Maybe[string]]](), true},
+		{reflect.TypeFor[*Maybe[int]](), false},
+		{reflect.TypeFor[Nullable[int]](), false},
+		{reflect.TypeFor[XMLNillable[int]](), false},
+		{reflect.TypeFor[EmptyAsValue[string]](), false},
+		{reflect.TypeFor[notMaybe](), false},
+		{reflect.TypeFor[int](), false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := IsMaybeType(tt.t); got != tt.expected {
+			t.Errorf("IsMaybeType(%v): expected %v, got %v", tt.t, tt.expected, got)
+		}
+	}
+}
+
+func TestMaybeElem(t *testing.T) {
+	if elem, ok := MaybeElem(reflect.TypeFor[Maybe[Person]]()); !ok || elem != reflect.TypeFor[Person]() {
+		t.Errorf("Expected (Person, true), got (%v, %v)", elem, ok)
+	}
+	if elem, ok := MaybeElem(reflect.TypeFor[int]()); ok || elem != nil {
+		t.Errorf("Expected (nil, false), got (%v, %v)", elem, ok)
+	}
+	if elem, ok := MaybeElem(reflect.TypeFor[EmptyAsValue[string]]()); ok || elem != nil {
+		t.Errorf("Expected (nil, false) for EmptyAsValue, got (%v, %v)", elem, ok)
+	}
+}
@@ -0,0 +1,49 @@
+package maybe
+
+import (
+	"context"
+	"fmt"
+)
+
+// ContextKey is a typed key for values stored in a context.Context.
+// Keys are compared by identity, so declare each key once, for example as a
+// package-level variable.
+type ContextKey[T any] struct {
+	name string
+}
+
+// NewContextKey returns a new key. The name is only used in diagnostics.
+func NewContextKey[T any](name string) *ContextKey[T] {
+	return &ContextKey[T]{name: name}
+}
+
+// WithValue returns a copy of ctx in which the key is associated with value.
+func (k *ContextKey[T]) WithValue(ctx context.Context, value T) context.Context {
+	return context.WithValue(ctx, k, value)
+}
+
+// Get returns Some with the value stored under the key, or None when it is
+// missing, has another type, or is a nil interface (see FromInterface).
+func (k *ContextKey[T]) Get(ctx context.Context) Maybe[T] {
+	value, ok := ctx.Value(k).(T)
+	if !ok {
+		return None[T]()
+	}
+	return FromInterface(value)
+}
+
+// MustGet returns the value stored under the key, or panics with an error
+// that wraps a *NoneError (and so ErrNone) and names the key.
+func (k *ContextKey[T]) MustGet(ctx context.Context) T {
+	if m := k.Get(ctx); m.hasValue {
+		return m.value
+	}
+	if value := ctx.Value(k); value != nil {
+		panic(fmt.Errorf("maybe: context key %q holds %T: %w", k.name, value, newNoneError[T]()))
+	}
+	panic(fmt.Errorf("maybe: context key %q: %w", k.name, newNoneError[T]()))
+}
+
+func (k *ContextKey[T]) String() string {
+	return k.name
+}
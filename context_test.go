@@ -0,0 +1,119 @@
+package maybe
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type contextUser struct {
+	Name string
+}
+
+func TestContextKey(t *testing.T) {
+	requestID := NewContextKey[string]("request-id")
+	user := NewContextKey[*contextUser]("user")
+	ctx := context.Background()
+
+	t.Run("missing value is None", func(t *testing.T) {
+		if m := requestID.Get(ctx); m.IsSome() {
+			t.Errorf("Expected None, got %v", m)
+		}
+	})
+
+	t.Run("stored value is Some", func(t *testing.T) {
+		ctx := requestID.WithValue(ctx, "abc")
+		if m := requestID.Get(ctx); m != Some("abc") {
+			t.Errorf("Expected Some(abc), got %v", m)
+		}
+		if v := requestID.MustGet(ctx); v != "abc" {
+			t.Errorf("Expected abc, got %q", v)
+		}
+	})
+
+	t.Run("zero value is Some", func(t *testing.T) {
+		ctx := requestID.WithValue(ctx, "")
+		if m := requestID.Get(ctx); m != Some("") {
+			t.Errorf("Expected Some(\"\"), got %v", m)
+		}
+	})
+
+	t.Run("keys with the same name and type are distinct", func(t *testing.T) {
+		other := NewContextKey[string]("request-id")
+		ctx := requestID.WithValue(ctx, "abc")
+		if m := other.Get(ctx); m.IsSome() {
+			t.Errorf("Expected None for a different key, got %v", m)
+		}
+	})
+
+	t.Run("value of another type is None", func(t *testing.T) {
+		ctx := context.WithValue(ctx, requestID, 42)
+		if m := requestID.Get(ctx); m.IsSome() {
+			t.Errorf("Expected None for a value of another type, got %v", m)
+		}
+	})
+
+	t.Run("nil pointer is Some(nil)", func(t *testing.T) {
+		ctx := user.WithValue(ctx, nil)
+		m := user.Get(ctx)
+		if !m.IsSome() {
+			t.Fatal("Expected Some(nil) for a nil pointer")
+		}
+		if v, _ := m.Unwrap(); v != nil {
+			t.Errorf("Expected nil, got %v", v)
+		}
+	})
+
+	t.Run("nil interface is None", func(t *testing.T) {
+		errKey := NewContextKey[error]("err")
+		ctx := errKey.WithValue(ctx, nil)
+		if m := errKey.Get(ctx); m.IsSome() {
+			t.Errorf("Expected None for a nil interface, got %v", m)
+		}
+		ctx = errKey.WithValue(ctx, errTest)
+		if m := errKey.Get(ctx); m != Some(errTest) {
+			t.Errorf("Expected Some(errTest), got %v", m)
+		}
+	})
+}
+
+func TestContextKeyMustGet(t *testing.T) {
+	key := NewContextKey[int]("attempt")
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{"missing", context.Background(), `maybe: context key "attempt": none: Maybe[int] has no value`},
+		{"wrong type", context.WithValue(context.Background(), key, "one"), `maybe: context key "attempt" holds string: none: Maybe[int] has no value`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				if !ok {
+					t.Fatal("Expected MustGet to panic with an error")
+				}
+				if !errors.Is(err, ErrNone) {
+					t.Errorf("Expected error to wrap ErrNone, got %v", err)
+				}
+				var noneErr *NoneError
+				if !errors.As(err, &noneErr) || noneErr.Type != "int" {
+					t.Errorf("Expected *NoneError for int, got %v", err)
+				}
+				if err.Error() != tt.expected {
+					t.Errorf("Expected %q, got %q", tt.expected, err.Error())
+				}
+			}()
+			key.MustGet(tt.ctx)
+		})
+	}
+
+	t.Run("String names the key in context output", func(t *testing.T) {
+		ctx := key.WithValue(context.Background(), 1)
+		if s := ctx.(interface{ String() string }).String(); !strings.Contains(s, "attempt") {
+			t.Errorf("Expected context string to contain the key name, got %s", s)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package maybe
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// RequiredError lists the paths of `maybe:"required"` fields that are None.
+type RequiredError struct {
+	Paths []string
+}
+
+func (e *RequiredError) Error() string {
+	return "maybe: missing required fields: " + strings.Join(e.Paths, ", ")
+}
+
+// DecodeJSON unmarshals data into v with encoding/json (and therefore
+// UnmarshalJSONFrom when built with GOEXPERIMENT=jsonv2), then applies the
+// `maybe` struct tags with ApplyTags.
+func DecodeJSON(data []byte, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	return ApplyTags(v)
+}
+
+// ApplyTags enforces `maybe` struct tags on the struct v points to,
+// recursing into nested structs, pointers, slices and arrays, and Some
+// values of Maybe fields:
+//   - `maybe:"required"` reports the field if it is None
+//   - `maybe:"default=30s"` sets a None field to the default, parsed as
+//     text like UnmarshalText, except that an empty default is a value
+//
+// All missing required fields are collected into a single *RequiredError.
+// Field paths use the JSON field names, e.g. "servers[1].port".
+func ApplyTags(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return errors.New("maybe: ApplyTags requires a non-nil pointer")
+	}
+	w := tagWalker{}
+	w.walk(rv.Elem(), "")
+	if len(w.missing) > 0 {
+		w.errs = append(w.errs, &RequiredError{Paths: w.missing})
+	}
+	return errors.Join(w.errs...)
+}
+
+type tagWalker struct {
+	missing []string
+	errs    []error
+}
+
+func (w *tagWalker) walk(rv reflect.Value, path string) {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if !rv.IsNil() {
+			w.walk(rv.Elem(), path)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range rv.Len() {
+			w.walk(rv.Index(i), path+"["+strconv.Itoa(i)+"]")
+		}
+	case reflect.Struct:
+		if IsMaybeType(rv.Type()) {
+			w.walkMaybe(rv, path)
+			return
+		}
+		rt := rv.Type()
+		for i := range rt.NumField() {
+			field := rt.Field(i)
+			if !isPromotable(field) {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			fieldPath := path
+			if !field.Anonymous || name != "" {
+				if name == "" {
+					name = field.Name
+				}
+				fieldPath = joinPath(path, name)
+			}
+			if tag, ok := field.Tag.Lookup("maybe"); ok {
+				w.applyTag(rv.Field(i), field, tag, fieldPath)
+			}
+			w.walk(rv.Field(i), fieldPath)
+		}
+	}
+}
+
+// walkMaybe recurses into the value of a Some, writing back any changes.
+func (w *tagWalker) walkMaybe(rv reflect.Value, path string) {
+	setter := rv.Addr().Interface().(AnyMaybeSetter)
+	switch setter.ElemType().Kind() {
+	case reflect.Struct, reflect.Pointer, reflect.Slice, reflect.Array:
+	default:
+		return
+	}
+	value, ok := setter.AnyValue()
+	if !ok || value == nil {
+		return
+	}
+	copied := reflect.New(setter.ElemType()).Elem()
+	copied.Set(reflect.ValueOf(value))
+	w.walk(copied, path)
+	if err := setter.SetAny(copied.Interface()); err != nil {
+		w.errs = append(w.errs, err)
+	}
+}
+
+func (w *tagWalker) applyTag(rv reflect.Value, field reflect.StructField, tag, path string) {
+	if !IsMaybeType(field.Type) {
+		w.errs = append(w.errs, fmt.Errorf("maybe: field %s has a maybe tag but type %s is not a Maybe", path, field.Type))
+		return
+	}
+	m := rv.Addr().Interface().(AnyMaybeSetter)
+	switch {
+	case tag == "required":
+		if m.IsNone() {
+			w.missing = append(w.missing, path)
+		}
+	case strings.HasPrefix(tag, "default="):
+		if m.IsSome() {
+			return
+		}
+		// Parse the default directly rather than with UnmarshalText, so that
+		// an empty default is a value (Some("") for strings) and not None.
+		value := reflect.New(m.ElemType())
+		if err := unmarshalText([]byte(strings.TrimPrefix(tag, "default=")), value.Interface()); err != nil {
+			w.errs = append(w.errs, fmt.Errorf("maybe: invalid default for %s: %w", path, err))
+			return
+		}
+		if err := m.SetAny(value.Elem().Interface()); err != nil {
+			w.errs = append(w.errs, err)
+		}
+	default:
+		w.errs = append(w.errs, fmt.Errorf("maybe: unknown tag %q on field %s", tag, path))
+	}
+}
+
+// isPromotable reports whether encoding/json decodes into field: exported
+// fields, and embedded structs of unexported type, whose exported fields are
+// promoted.
+func isPromotable(field reflect.StructField) bool {
+	if field.IsExported() {
+		return true
+	}
+	t := field.Type
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return field.Anonymous && t.Kind() == reflect.Struct
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
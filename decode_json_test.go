@@ -0,0 +1,154 @@
+package maybe
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type tagServer struct {
+	Host    Maybe[string]        `json:"host" maybe:"required"`
+	Port    Maybe[int]           `json:"port" maybe:"default=8080"`
+	Timeout Maybe[time.Duration] `json:"timeout" maybe:"default=30s"`
+}
+
+type tagDatabase struct {
+	DSN  Maybe[string] `json:"dsn" maybe:"required"`
+	Pool Maybe[int]    `json:"pool" maybe:"default=4"`
+}
+
+type tagConfig struct {
+	Name     Maybe[string]      `json:"name" maybe:"required"`
+	Retries  Maybe[int]         `json:"retries" maybe:"default=3"`
+	Verbose  Maybe[bool]        `json:"verbose"`
+	Primary  tagServer          `json:"primary"`
+	Servers  []tagServer        `json:"servers"`
+	Database Maybe[tagDatabase] `json:"database"`
+	Replica  *tagServer         `json:"replica,omitempty"`
+}
+
+func TestDecodeJSONDefaults(t *testing.T) {
+	var cfg tagConfig
+	data := `{"name": "app", "primary": {"host": "a"}, "servers": [{"host": "b", "port": 1}], "database": {"dsn": "x"}, "replica": {"host": "c"}}`
+	if err := DecodeJSON([]byte(data), &cfg); err != nil {
+		t.Fatalf("DecodeJSON failed: %v", err)
+	}
+	if cfg.Retries != Some(3) {
+		t.Errorf("Expected Retries Some(3), got %v", cfg.Retries)
+	}
+	if cfg.Verbose.IsSome() {
+		t.Errorf("Expected Verbose None, got %v", cfg.Verbose)
+	}
+	if cfg.Primary.Port != Some(8080) || cfg.Primary.Timeout != Some(30*time.Second) {
+		t.Errorf("Expected Primary defaults, got %+v", cfg.Primary)
+	}
+	if cfg.Servers[0].Port != Some(1) {
+		t.Errorf("Expected explicit Port Some(1) to be kept, got %v", cfg.Servers[0].Port)
+	}
+	if cfg.Servers[0].Timeout.IsNone() {
+		t.Errorf("Expected Servers[0] Timeout default, got %v", cfg.Servers[0].Timeout)
+	}
+	if db := cfg.Database.UnwrapUnsafe(); db.Pool != Some(4) {
+		t.Errorf("Expected Database Pool Some(4), got %v", db.Pool)
+	}
+	if cfg.Replica.Port != Some(8080) {
+		t.Errorf("Expected Replica Port Some(8080), got %v", cfg.Replica.Port)
+	}
+}
+
+func TestDecodeJSONRequired(t *testing.T) {
+	var cfg tagConfig
+	data := `{"servers": [{"host": "b"}, {"port": 1}], "database": {}}`
+	err := DecodeJSON([]byte(data), &cfg)
+
+	var reqErr *RequiredError
+	if !errors.As(err, &reqErr) {
+		t.Fatalf("Expected *RequiredError, got %v", err)
+	}
+	expected := []string{"name", "primary.host", "servers[1].host", "database.dsn"}
+	if !slices.Equal(reqErr.Paths, expected) {
+		t.Errorf("Expected missing %v, got %v", expected, reqErr.Paths)
+	}
+	if !strings.Contains(err.Error(), "name, primary.host, servers[1].host, database.dsn") {
+		t.Errorf("Expected all paths in the error message, got %q", err.Error())
+	}
+}
+
+type tagEmbedded struct {
+	A Maybe[int] `json:"a" maybe:"required"`
+	C Maybe[int] `json:"c" maybe:"default=5"`
+}
+
+func TestDecodeJSONEmbeddedUnexported(t *testing.T) {
+	var cfg struct {
+		tagEmbedded
+		B Maybe[int] `json:"b"`
+	}
+	err := DecodeJSON([]byte(`{"b": 1}`), &cfg)
+	var reqErr *RequiredError
+	if !errors.As(err, &reqErr) || !slices.Equal(reqErr.Paths, []string{"a"}) {
+		t.Errorf("Expected missing [a], got %v", err)
+	}
+	if cfg.C != Some(5) {
+		t.Errorf("Expected promoted default C Some(5), got %v", cfg.C)
+	}
+}
+
+func TestDecodeJSONErrors(t *testing.T) {
+	t.Run("syntax error", func(t *testing.T) {
+		var cfg tagConfig
+		if err := DecodeJSON([]byte(`{`), &cfg); err == nil {
+			t.Error("Expected syntax error")
+		}
+	})
+
+	t.Run("invalid default", func(t *testing.T) {
+		var cfg struct {
+			Port Maybe[int] `json:"port" maybe:"default=http"`
+		}
+		err := DecodeJSON([]byte(`{}`), &cfg)
+		if err == nil || !strings.Contains(err.Error(), "invalid default for port") {
+			t.Errorf("Expected invalid default error, got %v", err)
+		}
+	})
+
+	t.Run("empty default", func(t *testing.T) {
+		var cfg struct {
+			Suffix Maybe[string] `json:"suffix" maybe:"default="`
+			Port   Maybe[int]    `json:"port" maybe:"default="`
+		}
+		err := DecodeJSON([]byte(`{}`), &cfg)
+		if cfg.Suffix != Some("") {
+			t.Errorf("Expected empty default to be Some(\"\"), got %v", cfg.Suffix)
+		}
+		if err == nil || !strings.Contains(err.Error(), "invalid default for port") {
+			t.Errorf("Expected invalid default error for empty int, got %v", err)
+		}
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		var cfg struct {
+			Port Maybe[int] `maybe:"optional"`
+		}
+		if err := ApplyTags(&cfg); err == nil {
+			t.Error("Expected unknown tag error")
+		}
+	})
+
+	t.Run("tag on non-Maybe field", func(t *testing.T) {
+		var cfg struct {
+			Port int `maybe:"required"`
+		}
+		if err := ApplyTags(&cfg); err == nil {
+			t.Error("Expected error for tag on non-Maybe field")
+		}
+	})
+
+	t.Run("non-pointer", func(t *testing.T) {
+		if err := ApplyTags(tagConfig{}); err == nil {
+			t.Error("Expected error for non-pointer")
+		}
+	})
+}
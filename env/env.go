@@ -0,0 +1,168 @@
+// Package env populates structs of maybe.Maybe fields from environment
+// variables, leaving the fields of unset variables None.
+//
+//	type Config struct {
+//		Port    maybe.Maybe[int]           `env:"PORT"`
+//		Timeout maybe.Maybe[time.Duration] `env:"TIMEOUT"`
+//		DB      struct {
+//			Host maybe.Maybe[string] `env:"HOST"`
+//		} `env:"DB"`
+//	}
+//
+//	var cfg Config
+//	err := env.Load(&cfg, "APP") // reads APP_PORT, APP_TIMEOUT and APP_DB_HOST
+//
+// Variable names are the prefix, the tags of enclosing struct fields and the
+// field's own tag, joined with underscores. Untagged and embedded structs
+// keep the enclosing prefix. A tagged Maybe of a struct is Some if any of its
+// variables is set. Fields tagged `env:"-"` and untagged Maybe fields are
+// skipped.
+//
+// Values are parsed like maybe.Maybe's UnmarshalText: encoding.TextUnmarshaler
+// when *T implements it, time.ParseDuration for time.Duration, and strconv for
+// basic kinds. Every failure is reported as a *ParseError, joined into a
+// single error.
+package env
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+
+	maybe "github.com/zodimo/go-maybe"
+	"github.com/zodimo/go-maybe/internal/textparse"
+)
+
+// ParseError reports an environment variable whose value could not be parsed.
+type ParseError struct {
+	Var   string
+	Field string
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("env: %s (field %s): %v", e.Var, e.Field, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Loader reads environment variables into structs.
+type Loader struct {
+	// Prefix is prepended to every variable name, joined with an underscore.
+	Prefix string
+	// Lookup returns the value of a variable and whether it is set.
+	// It defaults to os.LookupEnv.
+	Lookup func(key string) (string, bool)
+}
+
+// Load populates the struct v points to from the environment, with variable
+// names prefixed by prefix.
+func Load(v any, prefix string) error {
+	return Loader{Prefix: prefix}.Load(v)
+}
+
+// Load populates the struct v points to from the environment.
+func (l Loader) Load(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("env: Load requires a non-nil pointer to a struct")
+	}
+	lookup := l.Lookup
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	w := walker{lookup: lookup}
+	w.walkStruct(rv.Elem(), l.Prefix, "")
+	return errors.Join(w.errs...)
+}
+
+type walker struct {
+	lookup func(string) (string, bool)
+	errs   []error
+}
+
+// walkStruct loads the fields of rv and reports whether any variable was set.
+func (w *walker) walkStruct(rv reflect.Value, prefix, path string) bool {
+	found := false
+	rt := rv.Type()
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		// Embedded structs of unexported type are still walked, as their
+		// exported fields are promoted.
+		if !field.IsExported() && !(field.Anonymous && isNestedStruct(field.Type)) {
+			continue
+		}
+		tag, tagged := field.Tag.Lookup("env")
+		if tag == "-" {
+			continue
+		}
+		fieldPath := joinPath(path, field.Name, ".")
+		fv := rv.Field(i)
+		switch {
+		case maybe.IsMaybeType(field.Type):
+			if !tagged {
+				continue
+			}
+			if w.loadMaybe(fv, joinPath(prefix, tag, "_"), fieldPath) {
+				found = true
+			}
+		case isNestedStruct(field.Type):
+			if w.walkStruct(fv, joinPath(prefix, tag, "_"), fieldPath) {
+				found = true
+			}
+		case tagged:
+			w.errs = append(w.errs, fmt.Errorf("env: field %s has an env tag but type %s is not a Maybe", fieldPath, field.Type))
+		}
+	}
+	return found
+}
+
+// loadMaybe loads the variable name into the Maybe rv, or the variables
+// prefixed by name when it holds a struct, and reports whether any was set.
+func (w *walker) loadMaybe(rv reflect.Value, name, path string) bool {
+	setter := rv.Addr().Interface().(maybe.AnyMaybeSetter)
+	elem := reflect.New(setter.ElemType()).Elem()
+	var found bool
+	if isNestedStruct(elem.Type()) {
+		found = w.walkStruct(elem, name, path)
+	} else {
+		var value string
+		value, found = w.lookup(name)
+		if found {
+			if err := textparse.Parse(elem, value); err != nil {
+				w.errs = append(w.errs, &ParseError{Var: name, Field: path, Err: err})
+				return true
+			}
+		}
+	}
+	if !found {
+		setter.Clear()
+		return false
+	}
+	if err := setter.SetAny(elem.Interface()); err != nil {
+		w.errs = append(w.errs, &ParseError{Var: name, Field: path, Err: err})
+	}
+	return true
+}
+
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// isNestedStruct reports whether t is a struct whose fields are loaded from
+// their own variables, rather than a value parsed from one variable.
+func isNestedStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !maybe.IsMaybeType(t) && !reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+func joinPath(prefix, name, sep string) string {
+	switch {
+	case prefix == "":
+		return name
+	case name == "":
+		return prefix
+	}
+	return prefix + sep + name
+}
@@ -0,0 +1,210 @@
+package env
+
+import (
+	"errors"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	maybe "github.com/zodimo/go-maybe"
+)
+
+type database struct {
+	Host maybe.Maybe[string] `env:"HOST"`
+	Port maybe.Maybe[uint16] `env:"PORT"`
+}
+
+type Logging struct {
+	Level maybe.Maybe[string] `env:"LOG_LEVEL"`
+}
+
+type config struct {
+	Name     maybe.Maybe[string]        `env:"NAME"`
+	Port     maybe.Maybe[int]           `env:"PORT"`
+	Debug    maybe.Maybe[bool]          `env:"DEBUG"`
+	Ratio    maybe.Maybe[float64]       `env:"RATIO"`
+	Timeout  maybe.Maybe[time.Duration] `env:"TIMEOUT"`
+	Addr     maybe.Maybe[netip.Addr]    `env:"ADDR"`
+	Workers  maybe.Maybe[*int]          `env:"WORKERS"`
+	Primary  database                   `env:"DB"`
+	Replica  maybe.Maybe[database]      `env:"REPLICA"`
+	Ignored  maybe.Maybe[string]        `env:"-"`
+	Untagged maybe.Maybe[string]
+	Logging
+}
+
+func lookupFrom(vars map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := vars[key]
+		return value, ok
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("set variables are parsed", func(t *testing.T) {
+		vars := map[string]string{
+			"APP_NAME":         "",
+			"APP_PORT":         "8080",
+			"APP_DEBUG":        "true",
+			"APP_RATIO":        "0.5",
+			"APP_TIMEOUT":      "1m30s",
+			"APP_ADDR":         "10.0.0.1",
+			"APP_WORKERS":      "4",
+			"APP_DB_HOST":      "db.local",
+			"APP_DB_PORT":      "5432",
+			"APP_REPLICA_HOST": "replica.local",
+			"APP_LOG_LEVEL":    "debug",
+			"APP_Untagged":     "x",
+			"APP_Ignored":      "x",
+		}
+		var cfg config
+		if err := (Loader{Prefix: "APP", Lookup: lookupFrom(vars)}).Load(&cfg); err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.Name != maybe.Some("") {
+			t.Errorf("Expected Name Some(\"\"), got %v", cfg.Name)
+		}
+		if cfg.Port != maybe.Some(8080) {
+			t.Errorf("Expected Port Some(8080), got %v", cfg.Port)
+		}
+		if cfg.Debug != maybe.Some(true) {
+			t.Errorf("Expected Debug Some(true), got %v", cfg.Debug)
+		}
+		if cfg.Ratio != maybe.Some(0.5) {
+			t.Errorf("Expected Ratio Some(0.5), got %v", cfg.Ratio)
+		}
+		if cfg.Timeout != maybe.Some(90*time.Second) {
+			t.Errorf("Expected Timeout Some(1m30s), got %v", cfg.Timeout)
+		}
+		if cfg.Addr != maybe.Some(netip.MustParseAddr("10.0.0.1")) {
+			t.Errorf("Expected Addr Some(10.0.0.1), got %v", cfg.Addr)
+		}
+		if workers, err := cfg.Workers.Unwrap(); err != nil || workers == nil || *workers != 4 {
+			t.Errorf("Expected Workers Some(&4), got %v", cfg.Workers)
+		}
+		expected := database{Host: maybe.Some("db.local"), Port: maybe.Some[uint16](5432)}
+		if cfg.Primary != expected {
+			t.Errorf("Expected Primary %+v, got %+v", expected, cfg.Primary)
+		}
+		if cfg.Replica != maybe.Some(database{Host: maybe.Some("replica.local")}) {
+			t.Errorf("Expected Replica Some with Host, got %v", cfg.Replica)
+		}
+		if cfg.Level != maybe.Some("debug") {
+			t.Errorf("Expected embedded Level Some(debug), got %v", cfg.Level)
+		}
+		if cfg.Ignored.IsSome() || cfg.Untagged.IsSome() {
+			t.Errorf("Expected ignored and untagged fields to be None, got %v and %v", cfg.Ignored, cfg.Untagged)
+		}
+	})
+
+	t.Run("unset variables are None", func(t *testing.T) {
+		cfg := config{Port: maybe.Some(1), Replica: maybe.Some(database{})}
+		if err := (Loader{Lookup: lookupFrom(nil)}).Load(&cfg); err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.Port.IsSome() || cfg.Timeout.IsSome() || cfg.Primary.Host.IsSome() {
+			t.Errorf("Expected unset fields to be None, got %+v", cfg)
+		}
+		if cfg.Replica.IsSome() {
+			t.Errorf("Expected Replica to be None when none of its variables are set, got %v", cfg.Replica)
+		}
+	})
+
+	t.Run("without prefix", func(t *testing.T) {
+		var cfg config
+		vars := map[string]string{"PORT": "1", "DB_HOST": "h"}
+		if err := (Loader{Lookup: lookupFrom(vars)}).Load(&cfg); err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.Port != maybe.Some(1) || cfg.Primary.Host != maybe.Some("h") {
+			t.Errorf("Expected Port Some(1) and Primary.Host Some(h), got %v and %v", cfg.Port, cfg.Primary.Host)
+		}
+	})
+
+	t.Run("embedded struct of unexported type", func(t *testing.T) {
+		var cfg struct {
+			database
+			Name maybe.Maybe[string] `env:"NAME"`
+		}
+		vars := map[string]string{"APP_HOST": "h", "APP_NAME": "n"}
+		if err := (Loader{Prefix: "APP", Lookup: lookupFrom(vars)}).Load(&cfg); err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.Host != maybe.Some("h") || cfg.Name != maybe.Some("n") {
+			t.Errorf("Expected Host Some(h) and Name Some(n), got %v and %v", cfg.Host, cfg.Name)
+		}
+	})
+
+	t.Run("os environment", func(t *testing.T) {
+		t.Setenv("ENVTEST_PORT", "9090")
+		var cfg config
+		if err := Load(&cfg, "ENVTEST"); err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.Port != maybe.Some(9090) {
+			t.Errorf("Expected Port Some(9090), got %v", cfg.Port)
+		}
+	})
+}
+
+func TestLoadErrors(t *testing.T) {
+	t.Run("every parse failure is reported", func(t *testing.T) {
+		vars := map[string]string{
+			"PORT":    "http",
+			"TIMEOUT": "soon",
+			"ADDR":    "nowhere",
+			"DB_PORT": "70000",
+			"DEBUG":   "true",
+		}
+		var cfg config
+		err := (Loader{Lookup: lookupFrom(vars)}).Load(&cfg)
+		if err == nil {
+			t.Fatal("Expected error")
+		}
+		var failed []string
+		for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+			var parseErr *ParseError
+			if !errors.As(e, &parseErr) {
+				t.Fatalf("Expected *ParseError, got %T", e)
+			}
+			failed = append(failed, parseErr.Var+"="+parseErr.Field)
+		}
+		expected := "PORT=Port,TIMEOUT=Timeout,ADDR=Addr,DB_PORT=Primary.Port"
+		if strings.Join(failed, ",") != expected {
+			t.Errorf("Expected %s, got %s", expected, strings.Join(failed, ","))
+		}
+		if !errors.Is(err, strconv.ErrRange) {
+			t.Error("Expected the joined error to wrap strconv.ErrRange")
+		}
+		if cfg.Debug != maybe.Some(true) {
+			t.Errorf("Expected valid variables to still be loaded, got %v", cfg.Debug)
+		}
+	})
+
+	t.Run("tag on non-Maybe field", func(t *testing.T) {
+		var cfg struct {
+			Port int `env:"PORT"`
+		}
+		if err := (Loader{Lookup: lookupFrom(nil)}).Load(&cfg); err == nil {
+			t.Error("Expected error for env tag on a non-Maybe field")
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		var cfg struct {
+			Hosts maybe.Maybe[[]string] `env:"HOSTS"`
+		}
+		err := (Loader{Lookup: lookupFrom(map[string]string{"HOSTS": "a,b"})}).Load(&cfg)
+		if err == nil {
+			t.Error("Expected error for unsupported type")
+		}
+	})
+
+	t.Run("non-pointer", func(t *testing.T) {
+		if err := Load(config{}, ""); err == nil {
+			t.Error("Expected error for non-pointer")
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package maybe
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrNone is returned when a value is requested from a None.
+// Use errors.Is(err, ErrNone) to detect it, including when wrapped in a *NoneError.
+var ErrNone = errors.New("none")
+
+// NoneError wraps ErrNone with the element type of the Maybe it came from.
+type NoneError struct {
+	// Type is the element type name, e.g. "int" for Maybe[int].
+	Type string
+}
+
+func newNoneError[T any]() *NoneError {
+	return &NoneError{
+		Type: reflect.TypeFor[T]().String(),
+	}
+}
+
+func (e *NoneError) Error() string {
+	return "none: Maybe[" + e.Type + "] has no value"
+}
+
+func (e *NoneError) Unwrap() error {
+	return ErrNone
+}
@@ -0,0 +1,92 @@
+package maybe
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrNone(t *testing.T) {
+	t.Run("Unwrap returns ErrNone", func(t *testing.T) {
+		_, err := None[int]().Unwrap()
+		if err != ErrNone {
+			t.Errorf("Expected ErrNone, got %v", err)
+		}
+	})
+
+	t.Run("OrElseError with nil error returns ErrNone", func(t *testing.T) {
+		_, err := None[int]().OrElseError(nil)
+		if !errors.Is(err, ErrNone) {
+			t.Errorf("Expected ErrNone, got %v", err)
+		}
+	})
+
+	t.Run("OrElseError with custom error does not wrap ErrNone", func(t *testing.T) {
+		_, err := None[int]().OrElseError(errTest)
+		if err != errTest || errors.Is(err, ErrNone) {
+			t.Errorf("Expected the custom error unchanged, got %v", err)
+		}
+	})
+
+	t.Run("Nullable Unwrap returns ErrNone", func(t *testing.T) {
+		_, err := Null[int]().Unwrap()
+		if !errors.Is(err, ErrNone) {
+			t.Errorf("Expected ErrNone, got %v", err)
+		}
+	})
+
+	t.Run("UnwrapUnsafe panics with NoneError", func(t *testing.T) {
+		defer func() {
+			err, ok := recover().(error)
+			if !ok {
+				t.Fatal("Expected panic with an error")
+			}
+			if !errors.Is(err, ErrNone) {
+				t.Errorf("Expected panic to wrap ErrNone, got %v", err)
+			}
+			var noneErr *NoneError
+			if !errors.As(err, &noneErr) {
+				t.Fatalf("Expected *NoneError, got %T", err)
+			}
+			if noneErr.Type != "maybe.Person" {
+				t.Errorf("Expected type 'maybe.Person', got %q", noneErr.Type)
+			}
+			expected := "none: Maybe[maybe.Person] has no value"
+			if err.Error() != expected {
+				t.Errorf("Expected %q, got %q", expected, err.Error())
+			}
+		}()
+		None[Person]().UnwrapUnsafe()
+	})
+
+	t.Run("NoneError names interface types", func(t *testing.T) {
+		if got := newNoneError[error]().Type; got != "error" {
+			t.Errorf("Expected 'error', got %q", got)
+		}
+	})
+}
+
+func TestUnwrapNoneDoesNotAllocate(t *testing.T) {
+	m := None[string]()
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = m.Unwrap()
+	})
+	if allocs != 0 {
+		t.Errorf("Expected Unwrap on None to be allocation-free, got %v allocs", allocs)
+	}
+}
+
+func BenchmarkUnwrapNone(b *testing.B) {
+	m := None[int]()
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = m.Unwrap()
+	}
+}
+
+func BenchmarkUnwrapSome(b *testing.B) {
+	m := Some(42)
+	b.ReportAllocs()
+	for b.Loop() {
+		_, _ = m.Unwrap()
+	}
+}
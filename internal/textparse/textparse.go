@@ -0,0 +1,92 @@
+// Package textparse parses text into values of types known only through
+// reflection. It is shared by Maybe's UnmarshalText, the flag binder and the
+// env package, so that they all accept the same text.
+package textparse
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Parse parses text into rv, which must be settable. It tries in order:
+//   - the type's own encoding.TextUnmarshaler
+//   - time.ParseDuration for time.Duration
+//   - for pointers, a new value parsed from text
+//   - strconv for basic kinds
+func Parse(rv reflect.Value, text string) error {
+	if !rv.CanSet() {
+		return fmt.Errorf("maybe: cannot parse text into unsettable %s", rv.Type())
+	}
+	if tu, ok := rv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(text))
+	}
+	if rv.Type() == reflect.TypeFor[time.Duration]() {
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return err
+		}
+		rv.SetInt(int64(d))
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Pointer:
+		ptr := reflect.New(rv.Type().Elem())
+		if err := Parse(ptr.Elem(), text); err != nil {
+			return err
+		}
+		rv.Set(ptr)
+		return nil
+	case reflect.String:
+		rv.SetString(text)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+		return nil
+	}
+	return fmt.Errorf("maybe: cannot unmarshal text into %s", rv.Type())
+}
+
+// CanParse reports whether Parse can parse into a value of type t.
+func CanParse(t reflect.Type) bool {
+	if reflect.PointerTo(t).Implements(reflect.TypeFor[encoding.TextUnmarshaler]()) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return CanParse(t.Elem())
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package textparse
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("kinds", func(t *testing.T) {
+		var cfg struct {
+			Port    int
+			Timeout time.Duration
+			Addr    netip.Addr
+			Workers *int
+		}
+		rv := reflect.ValueOf(&cfg).Elem()
+		for field, text := range map[string]string{"Port": "80", "Timeout": "2s", "Addr": "::1", "Workers": "4"} {
+			if err := Parse(rv.FieldByName(field), text); err != nil {
+				t.Fatalf("Parse %s failed: %v", field, err)
+			}
+		}
+		if cfg.Port != 80 || cfg.Timeout != 2*time.Second || cfg.Addr != netip.IPv6Loopback() {
+			t.Errorf("Expected parsed values, got %+v", cfg)
+		}
+		if cfg.Workers == nil || *cfg.Workers != 4 {
+			t.Errorf("Expected Workers &4, got %v", cfg.Workers)
+		}
+	})
+
+	t.Run("unsettable value returns error", func(t *testing.T) {
+		if err := Parse(reflect.ValueOf(1), "2"); err == nil {
+			t.Error("Expected error for unsettable value")
+		}
+	})
+
+	t.Run("unsupported kind returns error", func(t *testing.T) {
+		var hosts []string
+		if err := Parse(reflect.ValueOf(&hosts).Elem(), "a"); err == nil {
+			t.Error("Expected error for unsupported kind")
+		}
+	})
+}
+
+func TestCanParse(t *testing.T) {
+	tests := []struct {
+		t        reflect.Type
+		expected bool
+	}{
+		{reflect.TypeFor[int](), true},
+		{reflect.TypeFor[*int](), true},
+		{reflect.TypeFor[time.Duration](), true},
+		{reflect.TypeFor[netip.Addr](), true},
+		{reflect.TypeFor[[]string](), false},
+		{reflect.TypeFor[*[]string](), false},
+	}
+	for _, tt := range tests {
+		if got := CanParse(tt.t); got != tt.expected {
+			t.Errorf("CanParse(%v): expected %v, got %v", tt.t, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package maybe
+
+import "iter"
+
+// All returns an iterator that yields the value if present, and nothing otherwise.
+func (m Maybe[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if m.hasValue {
+			yield(m.value)
+		}
+	}
+}
+
+// First returns the first value of seq, or None if seq is empty.
+func First[T any](seq iter.Seq[T]) Maybe[T] {
+	for value := range seq {
+		return Some(value)
+	}
+	return None[T]()
+}
+
+// Last returns the last value of seq, or None if seq is empty.
+func Last[T any](seq iter.Seq[T]) Maybe[T] {
+	last := None[T]()
+	for value := range seq {
+		last = Some(value)
+	}
+	return last
+}
+
+// Nth returns the zero-based nth value of seq, or None if seq is too short or n is negative.
+func Nth[T any](seq iter.Seq[T], n int) Maybe[T] {
+	if n < 0 {
+		return None[T]()
+	}
+	i := 0
+	for value := range seq {
+		if i == n {
+			return Some(value)
+		}
+		i++
+	}
+	return None[T]()
+}
+
+// Find returns the first value of seq for which pred returns true, or None.
+func Find[T any](seq iter.Seq[T], pred func(T) bool) Maybe[T] {
+	for value := range seq {
+		if pred(value) {
+			return Some(value)
+		}
+	}
+	return None[T]()
+}
+
+// FilterMap applies f to each value of seq and yields the contents of the Some results.
+func FilterMap[T any, R any](seq iter.Seq[T], f func(T) Maybe[R]) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for value := range seq {
+			if m := f(value); m.hasValue {
+				if !yield(m.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Somes yields the contents of the Some values of seq, skipping None.
+func Somes[T any](seq iter.Seq[Maybe[T]]) iter.Seq[T] {
+	return FilterMap(seq, func(m Maybe[T]) Maybe[T] { return m })
+}
+
+// First2 returns the first key/value pair of seq, or None if seq is empty.
+func First2[K any, V any](seq iter.Seq2[K, V]) Maybe[Pair[K, V]] {
+	for k, v := range seq {
+		return Some(NewPair(k, v))
+	}
+	return None[Pair[K, V]]()
+}
+
+// Last2 returns the last key/value pair of seq, or None if seq is empty.
+func Last2[K any, V any](seq iter.Seq2[K, V]) Maybe[Pair[K, V]] {
+	last := None[Pair[K, V]]()
+	for k, v := range seq {
+		last = Some(NewPair(k, v))
+	}
+	return last
+}
+
+// Nth2 returns the zero-based nth key/value pair of seq, or None if seq is too short or n is negative.
+func Nth2[K any, V any](seq iter.Seq2[K, V], n int) Maybe[Pair[K, V]] {
+	if n < 0 {
+		return None[Pair[K, V]]()
+	}
+	i := 0
+	for k, v := range seq {
+		if i == n {
+			return Some(NewPair(k, v))
+		}
+		i++
+	}
+	return None[Pair[K, V]]()
+}
+
+// Find2 returns the first key/value pair of seq for which pred returns true, or None.
+func Find2[K any, V any](seq iter.Seq2[K, V], pred func(K, V) bool) Maybe[Pair[K, V]] {
+	for k, v := range seq {
+		if pred(k, v) {
+			return Some(NewPair(k, v))
+		}
+	}
+	return None[Pair[K, V]]()
+}
+
+// FilterMap2 applies f to each key/value pair of seq and yields the key with
+// the contents of the Some results.
+func FilterMap2[K any, V any, R any](seq iter.Seq2[K, V], f func(K, V) Maybe[R]) iter.Seq2[K, R] {
+	return func(yield func(K, R) bool) {
+		for k, v := range seq {
+			if m := f(k, v); m.hasValue {
+				if !yield(k, m.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Somes2 yields the key/value pairs of seq whose value is Some, skipping None.
+func Somes2[K any, V any](seq iter.Seq2[K, Maybe[V]]) iter.Seq2[K, V] {
+	return FilterMap2(seq, func(_ K, m Maybe[V]) Maybe[V] { return m })
+}
@@ -0,0 +1,127 @@
+package maybe
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	if got := slices.Collect(Some(1).All()); !slices.Equal(got, []int{1}) {
+		t.Errorf("Expected [1], got %v", got)
+	}
+	if got := slices.Collect(None[int]().All()); len(got) != 0 {
+		t.Errorf("Expected [], got %v", got)
+	}
+	for range Some(1).All() {
+		break
+	}
+}
+
+func TestFirstLastNth(t *testing.T) {
+	seq := slices.Values([]int{10, 20, 30})
+	empty := slices.Values([]int(nil))
+
+	if got := First(seq); got != Some(10) {
+		t.Errorf("First: expected Some(10), got %v", got)
+	}
+	if got := First(empty); got.IsSome() {
+		t.Errorf("First: expected None, got %v", got)
+	}
+	if got := Last(seq); got != Some(30) {
+		t.Errorf("Last: expected Some(30), got %v", got)
+	}
+	if got := Last(empty); got.IsSome() {
+		t.Errorf("Last: expected None, got %v", got)
+	}
+	if got := Nth(seq, 1); got != Some(20) {
+		t.Errorf("Nth: expected Some(20), got %v", got)
+	}
+	if got := Nth(seq, 3); got.IsSome() {
+		t.Errorf("Nth: expected None past the end, got %v", got)
+	}
+	if got := Nth(seq, -1); got.IsSome() {
+		t.Errorf("Nth: expected None for negative index, got %v", got)
+	}
+}
+
+func TestFind(t *testing.T) {
+	seq := slices.Values([]int{1, 4, 6})
+	even := func(x int) bool { return x%2 == 0 }
+	if got := Find(seq, even); got != Some(4) {
+		t.Errorf("Expected Some(4), got %v", got)
+	}
+	if got := Find(seq, func(x int) bool { return x > 10 }); got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	parse := func(s string) Maybe[int] {
+		if n, err := strconv.Atoi(s); err == nil {
+			return Some(n)
+		}
+		return None[int]()
+	}
+	seq := slices.Values([]string{"1", "x", "3", "y", "5"})
+	if got := slices.Collect(FilterMap(seq, parse)); !slices.Equal(got, []int{1, 3, 5}) {
+		t.Errorf("Expected [1 3 5], got %v", got)
+	}
+
+	var got []int
+	for n := range FilterMap(seq, parse) {
+		got = append(got, n)
+		if n == 3 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("Expected early stop at [1 3], got %v", got)
+	}
+}
+
+func TestSomes(t *testing.T) {
+	seq := slices.Values([]Maybe[int]{Some(1), None[int](), Some(3)})
+	if got := slices.Collect(Somes(seq)); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", got)
+	}
+}
+
+func TestSeq2Variants(t *testing.T) {
+	seq := slices.All([]string{"a", "b", "c"})
+
+	if got := First2(seq); got != Some(NewPair(0, "a")) {
+		t.Errorf("First2: expected Some({0 a}), got %v", got)
+	}
+	if got := Last2(seq); got != Some(NewPair(2, "c")) {
+		t.Errorf("Last2: expected Some({2 c}), got %v", got)
+	}
+	if got := Nth2(seq, 1); got != Some(NewPair(1, "b")) {
+		t.Errorf("Nth2: expected Some({1 b}), got %v", got)
+	}
+	if got := Nth2(seq, 5); got.IsSome() {
+		t.Errorf("Nth2: expected None, got %v", got)
+	}
+	if got := Find2(seq, func(_ int, v string) bool { return v == "c" }); got != Some(NewPair(2, "c")) {
+		t.Errorf("Find2: expected Some({2 c}), got %v", got)
+	}
+	if got := First2(slices.All([]string(nil))); got.IsSome() {
+		t.Errorf("First2: expected None, got %v", got)
+	}
+}
+
+func TestFilterMap2AndSomes2(t *testing.T) {
+	m := map[string]Maybe[int]{"a": Some(1), "b": None[int](), "c": Some(3)}
+	got := maps.Collect(Somes2(maps.All(m)))
+	if !maps.Equal(got, map[string]int{"a": 1, "c": 3}) {
+		t.Errorf("Expected map[a:1 c:3], got %v", got)
+	}
+
+	doubled := maps.Collect(FilterMap2(maps.All(m), func(_ string, v Maybe[int]) Maybe[int] {
+		return Map(v, func(x int) int { return x * 2 })
+	}))
+	if !maps.Equal(doubled, map[string]int{"a": 2, "c": 6}) {
+		t.Errorf("Expected map[a:2 c:6], got %v", doubled)
+	}
+}
@@ -1,7 +1,6 @@
 package maybe
 
 import (
-	"errors"
 	"fmt"
 )
 
@@ -34,19 +33,22 @@ func (m Maybe[T]) IsSome() bool {
 func (m Maybe[T]) IsNone() bool {
 	return !m.hasValue
 }
+
+// Unwrap returns the value, or ErrNone if the Maybe is None.
 func (m Maybe[T]) Unwrap() (T, error) {
 	if m.hasValue {
 		return m.value, nil
 	}
 	var zero T
-	return zero, errors.New("none")
+	return zero, ErrNone
 }
+
+// UnwrapUnsafe returns the value, and panics with a *NoneError if the Maybe is None.
 func (m Maybe[T]) UnwrapUnsafe() T {
-	value, err := m.Unwrap()
-	if err != nil {
-		panic(err)
+	if !m.hasValue {
+		panic(newNoneError[T]())
 	}
-	return value
+	return m.value
 }
 
 func (m Maybe[T]) UnwrapOr(defaultValue T) T {
@@ -85,10 +87,18 @@ func (m Maybe[T]) OrElseGet(f func() T) T {
 	}
 	return f()
 }
+
+// OrElseError returns the value, or err if the Maybe is None.
+// A caller-supplied err is returned as is, without wrapping ErrNone, so it
+// can still be compared directly. A nil err falls back to ErrNone so that
+// None is never reported as success.
 func (m Maybe[T]) OrElseError(err error) (T, error) {
 	if m.hasValue {
 		return m.value, nil
 	}
+	if err == nil {
+		err = ErrNone
+	}
 	var zero T
 	return zero, err
 }
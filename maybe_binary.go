@@ -0,0 +1,82 @@
+package maybe
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	binaryNone byte = 0
+	binarySome byte = 1
+)
+
+// AppendBinary implements encoding.BinaryAppender interface.
+// The encoding is a presence byte followed, for Some, by T's binary form:
+// T's own AppendBinary or MarshalBinary, or the little-endian encoding/binary
+// form for fixed-size values such as sized numerics.
+func (m Maybe[T]) AppendBinary(b []byte) ([]byte, error) {
+	if !m.hasValue {
+		return append(b, binaryNone), nil
+	}
+	b = append(b, binarySome)
+	switch v := any(m.value).(type) {
+	case encoding.BinaryAppender:
+		return v.AppendBinary(b)
+	case encoding.BinaryMarshaler:
+		data, err := v.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append(b, data...), nil
+	}
+	if binary.Size(m.value) < 0 {
+		return nil, fmt.Errorf("maybe: cannot marshal %T as binary", m.value)
+	}
+	return binary.Append(b, binary.LittleEndian, m.value)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler interface.
+func (m Maybe[T]) MarshalBinary() ([]byte, error) {
+	return m.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface.
+func (m *Maybe[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("maybe: empty binary data")
+	}
+	switch data[0] {
+	case binaryNone:
+		if len(data) != 1 {
+			return errors.New("maybe: unexpected binary data after None")
+		}
+		*m = None[T]()
+		return nil
+	case binarySome:
+	default:
+		return fmt.Errorf("maybe: invalid binary presence tag %d", data[0])
+	}
+
+	var value T
+	if bu, ok := any(&value).(encoding.BinaryUnmarshaler); ok {
+		if err := bu.UnmarshalBinary(data[1:]); err != nil {
+			return err
+		}
+		*m = Some(value)
+		return nil
+	}
+	if binary.Size(value) < 0 {
+		return fmt.Errorf("maybe: cannot unmarshal binary into %T", value)
+	}
+	n, err := binary.Decode(data[1:], binary.LittleEndian, &value)
+	if err != nil {
+		return err
+	}
+	if n != len(data)-1 {
+		return errors.New("maybe: unexpected binary data after value")
+	}
+	*m = Some(value)
+	return nil
+}
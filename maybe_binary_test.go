@@ -0,0 +1,185 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding"
+	"testing"
+	"time"
+)
+
+var (
+	_ encoding.BinaryAppender    = Maybe[int32]{}
+	_ encoding.BinaryMarshaler   = Maybe[int32]{}
+	_ encoding.BinaryUnmarshaler = (*Maybe[int32])(nil)
+)
+
+type binaryPoint struct {
+	X, Y int16
+}
+
+func TestMarshalBinary(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        encoding.BinaryMarshaler
+		expected []byte
+	}{
+		{"None", None[int32](), []byte{0}},
+		{"int32", Some(int32(258)), []byte{1, 2, 1, 0, 0}},
+		{"bool", Some(true), []byte{1, 1}},
+		{"fixed-size struct", Some(binaryPoint{1, -1}), []byte{1, 1, 0, 0xff, 0xff}},
+		{"nested", Some(Some(uint16(1))), []byte{1, 1, 1, 0}},
+		{"nested None", Some(None[uint16]()), []byte{1, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.m.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary failed: %v", err)
+			}
+			if !bytes.Equal(data, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, data)
+			}
+		})
+	}
+
+	t.Run("unsupported type", func(t *testing.T) {
+		if _, err := Some(42).MarshalBinary(); err == nil {
+			t.Error("Expected error for int, which has no fixed size")
+		}
+		if _, err := Some("x").MarshalBinary(); err == nil {
+			t.Error("Expected error for string")
+		}
+	})
+
+	t.Run("AppendBinary appends", func(t *testing.T) {
+		data, err := Some(int8(5)).AppendBinary([]byte{9})
+		if err != nil || !bytes.Equal(data, []byte{9, 1, 5}) {
+			t.Errorf("Expected [9 1 5], got %v (%v)", data, err)
+		}
+	})
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	t.Run("fixed-size values", func(t *testing.T) {
+		for _, in := range []Maybe[float64]{Some(1.5), Some(0.0), None[float64]()} {
+			data, err := in.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary failed: %v", err)
+			}
+			var out Maybe[float64]
+			if err := out.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary failed: %v", err)
+			}
+			if out != in {
+				t.Errorf("Expected %v, got %v", in, out)
+			}
+		}
+	})
+
+	t.Run("BinaryMarshaler values", func(t *testing.T) {
+		in := Some(time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC))
+		data, err := in.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed: %v", err)
+		}
+		var out Maybe[time.Time]
+		if err := out.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		if !out.UnwrapUnsafe().Equal(in.UnwrapUnsafe()) {
+			t.Errorf("Expected %v, got %v", in, out)
+		}
+	})
+
+	t.Run("nested values", func(t *testing.T) {
+		for _, in := range []Maybe[Maybe[binaryPoint]]{Some(Some(binaryPoint{3, 4})), Some(None[binaryPoint]()), None[Maybe[binaryPoint]]()} {
+			data, err := in.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary failed: %v", err)
+			}
+			var out Maybe[Maybe[binaryPoint]]
+			if err := out.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary failed: %v", err)
+			}
+			if out != in {
+				t.Errorf("Expected %v, got %v", in, out)
+			}
+		}
+	})
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":           nil,
+		"invalid tag":     {2},
+		"None with data":  {0, 1},
+		"missing value":   {1},
+		"truncated value": {1, 1, 2},
+		"trailing data":   {1, 1, 2, 3, 4, 5},
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			var m Maybe[int32]
+			if err := m.UnmarshalBinary(data); err == nil {
+				t.Errorf("Expected error, got %v", m)
+			}
+		})
+	}
+
+	t.Run("invalid nested", func(t *testing.T) {
+		var m Maybe[Maybe[int32]]
+		if err := m.UnmarshalBinary([]byte{1, 7}); err == nil {
+			t.Errorf("Expected error for invalid nested tag, got %v", m)
+		}
+		if err := m.UnmarshalBinary([]byte{1, 1}); err == nil {
+			t.Errorf("Expected error for truncated nested value, got %v", m)
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		var m Maybe[string]
+		if err := m.UnmarshalBinary([]byte{1, 'x'}); err == nil {
+			t.Error("Expected error for string")
+		}
+	})
+}
+
+func FuzzUnmarshalBinaryInt64(f *testing.F) {
+	f.Add([]byte{0})
+	f.Add([]byte{1, 1, 2, 3, 4, 5, 6, 7, 8})
+	f.Add([]byte{1, 1})
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var m Maybe[int64]
+		if err := m.UnmarshalBinary(data); err != nil {
+			return
+		}
+		out, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed after successful UnmarshalBinary: %v", err)
+		}
+		if !bytes.Equal(out, data) {
+			t.Errorf("Round trip mismatch: %v -> %v -> %v", data, m, out)
+		}
+	})
+}
+
+func FuzzUnmarshalBinaryNested(f *testing.F) {
+	f.Add([]byte{0})
+	f.Add([]byte{1, 0})
+	f.Add([]byte{1, 1, 4, 0, 5, 0})
+	f.Add([]byte{1, 1, 4})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var m Maybe[Maybe[binaryPoint]]
+		if err := m.UnmarshalBinary(data); err != nil {
+			return
+		}
+		out, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed after successful UnmarshalBinary: %v", err)
+		}
+		if !bytes.Equal(out, data) {
+			t.Errorf("Round trip mismatch: %v -> %v -> %v", data, m, out)
+		}
+	})
+}
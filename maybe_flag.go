@@ -0,0 +1,169 @@
+package maybe
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/zodimo/go-maybe/internal/textparse"
+)
+
+// Flag adapts a *Maybe[T] to flag.Value and flag.Getter, so that a flag that
+// was not given stays None and can be told apart from one given the zero value.
+// Values are parsed like UnmarshalText.
+type Flag[T any] struct {
+	target *Maybe[T]
+}
+
+// NewFlag returns a Flag that sets m.
+func NewFlag[T any](m *Maybe[T]) *Flag[T] {
+	return &Flag[T]{target: m}
+}
+
+// String returns the value of a Some flag, or empty text for None.
+func (f *Flag[T]) String() string {
+	if f == nil || f.target == nil || !f.target.hasValue {
+		return ""
+	}
+	return fmt.Sprint(f.target.value)
+}
+
+// Set parses s and sets the Maybe to Some.
+func (f *Flag[T]) Set(s string) error {
+	var value T
+	if err := unmarshalText([]byte(s), &value); err != nil {
+		return err
+	}
+	*f.target = Some(value)
+	return nil
+}
+
+// Get returns the Maybe[T] the flag sets.
+func (f *Flag[T]) Get() any {
+	return *f.target
+}
+
+// IsBoolFlag lets boolean flags be given without a value, as in -verbose.
+func (f *Flag[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
+
+// FlagVar defines a flag in fs that sets m when given.
+func FlagVar[T any](fs *flag.FlagSet, m *Maybe[T], name string, usage string) {
+	fs.Var(NewFlag(m), name, usage)
+}
+
+func StringVar(fs *flag.FlagSet, m *Maybe[string], name string, usage string) {
+	FlagVar(fs, m, name, usage)
+}
+func BoolVar(fs *flag.FlagSet, m *Maybe[bool], name string, usage string) {
+	FlagVar(fs, m, name, usage)
+}
+func IntVar(fs *flag.FlagSet, m *Maybe[int], name string, usage string) {
+	FlagVar(fs, m, name, usage)
+}
+func Int64Var(fs *flag.FlagSet, m *Maybe[int64], name string, usage string) {
+	FlagVar(fs, m, name, usage)
+}
+func UintVar(fs *flag.FlagSet, m *Maybe[uint], name string, usage string) {
+	FlagVar(fs, m, name, usage)
+}
+func Uint64Var(fs *flag.FlagSet, m *Maybe[uint64], name string, usage string) {
+	FlagVar(fs, m, name, usage)
+}
+func Float64Var(fs *flag.FlagSet, m *Maybe[float64], name string, usage string) {
+	FlagVar(fs, m, name, usage)
+}
+func DurationVar(fs *flag.FlagSet, m *Maybe[time.Duration], name string, usage string) {
+	FlagVar(fs, m, name, usage)
+}
+
+// BindFlags defines a flag in fs for every Maybe field of the struct v
+// points to, recursing into nested structs:
+//   - the flag name comes from the `flag` tag, or the lower-cased field name
+//   - names of fields in nested structs are prefixed with the struct field's
+//     name and a dot, as in "db.host"; embedded structs are flattened
+//   - the usage text comes from the `usage` tag
+//   - fields tagged `flag:"-"` are skipped
+//
+// An error is returned if a field's type cannot be parsed from text.
+func BindFlags(fs *flag.FlagSet, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("maybe: BindFlags requires a non-nil pointer to a struct")
+	}
+	return bindFlags(fs, rv.Elem(), "")
+}
+
+func bindFlags(fs *flag.FlagSet, rv reflect.Value, prefix string) error {
+	rt := rv.Type()
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		name := field.Tag.Get("flag")
+		// Embedded structs of unexported type are still flattened, as their
+		// exported fields are promoted.
+		embedded := field.Anonymous && field.Type.Kind() == reflect.Struct
+		if !field.IsExported() && !embedded || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		switch {
+		case IsMaybeType(field.Type):
+			setter := rv.Field(i).Addr().Interface().(AnyMaybeSetter)
+			elem := setter.ElemType()
+			if !textparse.CanParse(elem) {
+				return fmt.Errorf("maybe: cannot bind flag %s to field %s of type %s", name, field.Name, field.Type)
+			}
+			fs.Var(anyFlag{setter}, name, field.Tag.Get("usage"))
+		case field.Type.Kind() == reflect.Struct:
+			nestedPrefix := name
+			if field.Anonymous {
+				nestedPrefix = prefix
+			}
+			if err := bindFlags(fs, rv.Field(i), nestedPrefix); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// anyFlag is the flag.Value BindFlags uses for a Maybe whose T is only known
+// at runtime.
+type anyFlag struct {
+	m AnyMaybeSetter
+}
+
+func (f anyFlag) String() string {
+	if f.m == nil {
+		return ""
+	}
+	if value, ok := f.m.AnyValue(); ok {
+		return fmt.Sprint(value)
+	}
+	return ""
+}
+
+func (f anyFlag) Set(s string) error {
+	value := reflect.New(f.m.ElemType()).Elem()
+	if err := textparse.Parse(value, s); err != nil {
+		return err
+	}
+	return f.m.SetAny(value.Interface())
+}
+
+func (f anyFlag) Get() any {
+	return reflect.ValueOf(f.m).Elem().Interface()
+}
+
+func (f anyFlag) IsBoolFlag() bool {
+	return f.m.ElemType().Kind() == reflect.Bool
+}
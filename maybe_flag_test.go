@@ -0,0 +1,204 @@
+package maybe
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func TestFlag(t *testing.T) {
+	var (
+		name    Maybe[string]
+		port    Maybe[int]
+		verbose Maybe[bool]
+		timeout Maybe[time.Duration]
+		ratio   Maybe[float64]
+		addr    Maybe[netip.Addr]
+	)
+	register := func() *flag.FlagSet {
+		name, port, verbose, timeout, ratio, addr = None[string](), None[int](), None[bool](), None[time.Duration](), None[float64](), None[netip.Addr]()
+		fs := newTestFlagSet()
+		StringVar(fs, &name, "name", "")
+		IntVar(fs, &port, "port", "")
+		BoolVar(fs, &verbose, "verbose", "")
+		DurationVar(fs, &timeout, "timeout", "")
+		Float64Var(fs, &ratio, "ratio", "")
+		FlagVar(fs, &addr, "addr", "")
+		return fs
+	}
+
+	t.Run("flags not given stay None", func(t *testing.T) {
+		fs := register()
+		if err := fs.Parse(nil); err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if name.IsSome() || port.IsSome() || verbose.IsSome() || timeout.IsSome() || ratio.IsSome() || addr.IsSome() {
+			t.Error("Expected all flags to be None")
+		}
+	})
+
+	t.Run("zero values are Some", func(t *testing.T) {
+		fs := register()
+		if err := fs.Parse([]string{"-name=", "-port", "0", "-verbose=false", "-timeout=0s", "-ratio=0"}); err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if name != Some("") || port != Some(0) || verbose != Some(false) || timeout != Some(time.Duration(0)) || ratio != Some(0.0) {
+			t.Errorf("Expected Some zero values, got %v %v %v %v %v", name, port, verbose, timeout, ratio)
+		}
+	})
+
+	t.Run("values are parsed", func(t *testing.T) {
+		fs := register()
+		if err := fs.Parse([]string{"-name=app", "-port=8080", "-verbose", "-timeout=1m30s", "-ratio=0.5", "-addr=10.0.0.1"}); err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if name != Some("app") || port != Some(8080) || verbose != Some(true) || timeout != Some(90*time.Second) || ratio != Some(0.5) {
+			t.Errorf("Expected parsed values, got %v %v %v %v %v", name, port, verbose, timeout, ratio)
+		}
+		if addr != Some(netip.MustParseAddr("10.0.0.1")) {
+			t.Errorf("Expected addr Some(10.0.0.1), got %v", addr)
+		}
+	})
+
+	t.Run("invalid value returns error", func(t *testing.T) {
+		fs := register()
+		if err := fs.Parse([]string{"-port=http"}); err == nil {
+			t.Error("Expected error for invalid int")
+		}
+		if port.IsSome() {
+			t.Errorf("Expected port to stay None, got %v", port)
+		}
+	})
+
+	t.Run("Get and String", func(t *testing.T) {
+		fs := register()
+		if err := fs.Parse([]string{"-port=80"}); err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		portFlag := fs.Lookup("port").Value.(flag.Getter)
+		if portFlag.Get() != Some(80) || portFlag.String() != "80" {
+			t.Errorf("Expected Some(80) and \"80\", got %v and %q", portFlag.Get(), portFlag.String())
+		}
+		nameFlag := fs.Lookup("name").Value.(flag.Getter)
+		if nameFlag.Get() != None[string]() || nameFlag.String() != "" {
+			t.Errorf("Expected None and empty text, got %v and %q", nameFlag.Get(), nameFlag.String())
+		}
+	})
+}
+
+type flagDatabase struct {
+	Host Maybe[string] `usage:"database host"`
+	Port Maybe[int]
+}
+
+type FlagLogging struct {
+	Level Maybe[string] `flag:"log-level"`
+}
+
+type flagConfig struct {
+	Name     Maybe[string]        `flag:"name" usage:"service name"`
+	Timeout  Maybe[time.Duration] `flag:"timeout"`
+	Verbose  Maybe[bool]
+	DB       flagDatabase
+	Skipped  Maybe[int] `flag:"-"`
+	Plain    int
+	internal Maybe[int]
+	FlagLogging
+}
+
+func TestBindFlags(t *testing.T) {
+	t.Run("fields are registered", func(t *testing.T) {
+		var cfg flagConfig
+		fs := newTestFlagSet()
+		if err := BindFlags(fs, &cfg); err != nil {
+			t.Fatalf("BindFlags failed: %v", err)
+		}
+		var names []string
+		fs.VisitAll(func(f *flag.Flag) { names = append(names, f.Name) })
+		expected := "db.host,db.port,log-level,name,timeout,verbose"
+		if strings.Join(names, ",") != expected {
+			t.Errorf("Expected flags %s, got %s", expected, strings.Join(names, ","))
+		}
+		if usage := fs.Lookup("db.host").Usage; usage != "database host" {
+			t.Errorf("Expected usage from tag, got %q", usage)
+		}
+	})
+
+	t.Run("given flags are Some", func(t *testing.T) {
+		var cfg flagConfig
+		fs := newTestFlagSet()
+		if err := BindFlags(fs, &cfg); err != nil {
+			t.Fatalf("BindFlags failed: %v", err)
+		}
+		if err := fs.Parse([]string{"-timeout=5s", "-verbose", "-db.port=5432", "-log-level=debug"}); err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if cfg.Name.IsSome() || cfg.DB.Host.IsSome() {
+			t.Errorf("Expected flags not given to be None, got %v and %v", cfg.Name, cfg.DB.Host)
+		}
+		if cfg.Timeout != Some(5*time.Second) || cfg.Verbose != Some(true) || cfg.DB.Port != Some(5432) || cfg.Level != Some("debug") {
+			t.Errorf("Expected given flags to be Some, got %+v", cfg)
+		}
+		if got := fs.Lookup("db.port").Value.(flag.Getter).Get(); got != Some(5432) {
+			t.Errorf("Expected Get to return Some(5432), got %v", got)
+		}
+	})
+
+	t.Run("defaults are printed for Some fields", func(t *testing.T) {
+		cfg := flagConfig{Name: Some("app")}
+		fs := newTestFlagSet()
+		if err := BindFlags(fs, &cfg); err != nil {
+			t.Fatalf("BindFlags failed: %v", err)
+		}
+		var out bytes.Buffer
+		fs.SetOutput(&out)
+		fs.PrintDefaults()
+		if !strings.Contains(out.String(), `service name (default app)`) {
+			t.Errorf("Expected default for name, got %s", out.String())
+		}
+		if strings.Contains(out.String(), `database host (default`) {
+			t.Errorf("Expected no default for None field, got %s", out.String())
+		}
+	})
+
+	t.Run("embedded struct of unexported type", func(t *testing.T) {
+		var cfg struct {
+			flagDatabase
+		}
+		fs := newTestFlagSet()
+		if err := BindFlags(fs, &cfg); err != nil {
+			t.Fatalf("BindFlags failed: %v", err)
+		}
+		if err := fs.Parse([]string{"-host=h"}); err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if cfg.Host != Some("h") {
+			t.Errorf("Expected Host Some(h), got %v", cfg.Host)
+		}
+	})
+
+	t.Run("unsupported type returns error", func(t *testing.T) {
+		var cfg struct {
+			Hosts Maybe[[]string]
+		}
+		if err := BindFlags(newTestFlagSet(), &cfg); err == nil {
+			t.Error("Expected error for unsupported field type")
+		}
+	})
+
+	t.Run("non-pointer returns error", func(t *testing.T) {
+		if err := BindFlags(newTestFlagSet(), flagConfig{}); err == nil {
+			t.Error("Expected error for non-pointer")
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package maybe
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+// Format implements fmt.Formatter interface.
+//   - %v prints the bare value, or <none> for None
+//   - %+v prints the verbose String form, e.g. Some[int](42)
+//   - %#v prints the GoString form, e.g. maybe.Some[int](42)
+//
+// Any other verb, together with its flags, width and precision, is applied to
+// the value; None prints <none> padded to the width.
+func (m Maybe[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		io.WriteString(f, m.GoString())
+	case verb == 'v' && f.Flag('+'):
+		io.WriteString(f, m.String())
+	case m.hasValue:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), m.value)
+	default:
+		format := "%"
+		if f.Flag('-') {
+			format += "-"
+		}
+		if width, ok := f.Width(); ok {
+			format += strconv.Itoa(width)
+		}
+		fmt.Fprintf(f, format+"s", "<none>")
+	}
+}
+
+// GoString implements fmt.GoStringer interface.
+// It returns a Go expression that constructs the Maybe, e.g. maybe.Some[int](42) or maybe.None[int]().
+func (m Maybe[T]) GoString() string {
+	typeName := reflect.TypeFor[T]().String()
+	if m.hasValue {
+		return fmt.Sprintf("maybe.Some[%s](%#v)", typeName, m.value)
+	}
+	return fmt.Sprintf("maybe.None[%s]()", typeName)
+}
@@ -0,0 +1,53 @@
+package maybe
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format   string
+		value    any
+		expected string
+	}{
+		{"%v", Some(42), "42"},
+		{"%v", None[int](), "<none>"},
+		{"%s", Some("hello"), "hello"},
+		{"%+v", Some(42), "Some[int](42)"},
+		{"%+v", None[int](), "None[int]()"},
+		{"%+v", Some(Person{Name: "John", Age: 30}), "Some[maybe.Person]({John 30})"},
+		{"%#v", Some(42), "maybe.Some[int](42)"},
+		{"%#v", Some("hi"), `maybe.Some[string]("hi")`},
+		{"%#v", None[string](), "maybe.None[string]()"},
+		{"%#v", Some(Person{Name: "John", Age: 30}), `maybe.Some[maybe.Person](maybe.Person{Name:"John", Age:30})`},
+		{"%#v", None[error](), "maybe.None[error]()"},
+		{"%5d", Some(42), "   42"},
+		{"%-5d|", Some(42), "42   |"},
+		{"%05d", Some(42), "00042"},
+		{"%+d", Some(42), "+42"},
+		{"%x", Some(255), "ff"},
+		{"%.2f", Some(3.14159), "3.14"},
+		{"%8.3f", Some(3.14159), "   3.142"},
+		{"%q", Some("hi"), `"hi"`},
+		{"%8v", None[int](), "  <none>"},
+		{"%-8v|", None[int](), "<none>  |"},
+		{"%d", None[int](), "<none>"},
+		{"%v", Some(Some(1)), "1"},
+		{"%v", []Maybe[int]{Some(1), None[int]()}, "[1 <none>]"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.value); got != tt.expected {
+			t.Errorf("Sprintf(%q, %+v): expected %q, got %q", tt.format, tt.value, tt.expected, got)
+		}
+	}
+}
+
+func TestGoString(t *testing.T) {
+	if got := Some(1.5).GoString(); got != "maybe.Some[float64](1.5)" {
+		t.Errorf("Expected 'maybe.Some[float64](1.5)', got %q", got)
+	}
+	if got := None[*int]().GoString(); got != "maybe.None[*int]()" {
+		t.Errorf("Expected 'maybe.None[*int]()', got %q", got)
+	}
+}
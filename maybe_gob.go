@@ -0,0 +1,54 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+)
+
+const (
+	gobNone byte = 0
+	gobSome byte = 1
+)
+
+// gobValue wraps the value so that gob can encode values it refuses at the
+// top level, such as nil pointers.
+type gobValue[T any] struct {
+	Value T
+}
+
+// GobEncode implements gob.GobEncoder interface.
+// The encoding is a presence byte followed, for Some, by the gob encoding of the value.
+func (m Maybe[T]) GobEncode() ([]byte, error) {
+	if !m.hasValue {
+		return []byte{gobNone}, nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte(gobSome)
+	if err := gob.NewEncoder(&buf).Encode(gobValue[T]{Value: m.value}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder interface.
+func (m *Maybe[T]) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("maybe: empty gob data")
+	}
+	switch data[0] {
+	case gobNone:
+		*m = None[T]()
+		return nil
+	case gobSome:
+		var v gobValue[T]
+		if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&v); err != nil {
+			return err
+		}
+		*m = Some(v.Value)
+		return nil
+	default:
+		return fmt.Errorf("maybe: invalid gob presence tag %d", data[0])
+	}
+}
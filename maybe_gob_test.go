@@ -0,0 +1,96 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func gobRoundTrip[T any](t *testing.T, in T) T {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	var out T
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	return out
+}
+
+type gobSession struct {
+	User    string
+	Expires Maybe[int64]
+	Profile Maybe[Person]
+	Theme   Maybe[string]
+}
+
+func TestGobScalar(t *testing.T) {
+	for _, in := range []Maybe[int]{Some(42), Some(0), None[int]()} {
+		if out := gobRoundTrip(t, in); out != in {
+			t.Errorf("Expected %v, got %v", in, out)
+		}
+	}
+	for _, in := range []Maybe[string]{Some("hello"), Some(""), None[string]()} {
+		if out := gobRoundTrip(t, in); out != in {
+			t.Errorf("Expected %v, got %v", in, out)
+		}
+	}
+}
+
+func TestGobStruct(t *testing.T) {
+	in := Some(Person{Name: "John", Age: 30})
+	if out := gobRoundTrip(t, in); out != in {
+		t.Errorf("Expected %v, got %v", in, out)
+	}
+
+	session := gobSession{User: "bob", Expires: Some(int64(0)), Profile: Some(Person{Name: "Bob"})}
+	if out := gobRoundTrip(t, session); out != session {
+		t.Errorf("Expected %+v, got %+v", session, out)
+	}
+}
+
+func TestGobPointer(t *testing.T) {
+	t.Run("Some(ptr)", func(t *testing.T) {
+		value := 5
+		out := gobRoundTrip(t, Some(&value))
+		if ptr, err := out.Unwrap(); err != nil || ptr == nil || *ptr != 5 {
+			t.Errorf("Expected Some(&5), got %v", out)
+		}
+	})
+
+	t.Run("Some(nil)", func(t *testing.T) {
+		out := gobRoundTrip(t, Some[*int](nil))
+		if ptr, err := out.Unwrap(); err != nil || ptr != nil {
+			t.Errorf("Expected Some(nil), got %v", out)
+		}
+	})
+
+	t.Run("None", func(t *testing.T) {
+		if out := gobRoundTrip(t, None[*int]()); out.IsSome() {
+			t.Errorf("Expected None, got %v", out)
+		}
+	})
+}
+
+func TestGobNested(t *testing.T) {
+	for _, in := range []Maybe[Maybe[int]]{Some(Some(3)), Some(None[int]()), None[Maybe[int]]()} {
+		if out := gobRoundTrip(t, in); out != in {
+			t.Errorf("Expected %v, got %v", in, out)
+		}
+	}
+}
+
+func TestGobDecodeInvalid(t *testing.T) {
+	var m Maybe[int]
+	if err := m.GobDecode(nil); err == nil {
+		t.Error("Expected error for empty data")
+	}
+	if err := m.GobDecode([]byte{7}); err == nil {
+		t.Error("Expected error for invalid presence tag")
+	}
+	if err := m.GobDecode([]byte{gobSome, 0xff}); err == nil {
+		t.Error("Expected error for truncated value")
+	}
+}
@@ -0,0 +1,32 @@
+//go:build !goexperiment.jsonv2
+
+package maybe
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler interface.
+// None values are written as null; use the 'omitzero' tag to omit them instead.
+func (m Maybe[T]) MarshalJSON() ([]byte, error) {
+	if !m.hasValue {
+		// When used with omitzero, this isn't called for None values.
+		// Anywhere else a value is required, so output null.
+		return []byte("null"), nil
+	}
+	return json.Marshal(m.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// Sets hasValue=true when any JSON token is encountered for this field.
+func (m *Maybe[T]) UnmarshalJSON(data []byte) error {
+	var ptr *T
+	if err := json.Unmarshal(data, &ptr); err != nil {
+		return err
+	}
+	if ptr == nil {
+		m.setNull()
+		return nil
+	}
+	m.hasValue = true
+	m.value = *ptr
+	return nil
+}
@@ -0,0 +1,249 @@
+package maybe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// The tests in this file use the encoding/json (v1) API and therefore run
+// against both the default build and GOEXPERIMENT=jsonv2.
+
+type jsonConfigScalar struct {
+	Name    string        `json:"name"`
+	Timeout Maybe[int]    `json:"timeout,omitzero"`
+	Enabled Maybe[bool]   `json:"enabled,omitzero"`
+	Label   Maybe[string] `json:"label,omitzero"`
+}
+
+type jsonAddress struct {
+	Street     string        `json:"street"`
+	PostalCode Maybe[string] `json:"postalcode,omitzero"`
+}
+
+type jsonConfigObject struct {
+	Name    string             `json:"name"`
+	Address Maybe[jsonAddress] `json:"address,omitzero"`
+}
+
+type jsonConfigPointer struct {
+	Val Maybe[*int] `json:"val"`
+}
+
+func TestJSONUnmarshalOmitted(t *testing.T) {
+	t.Run("omitted scalar fields are None", func(t *testing.T) {
+		var cfg jsonConfigScalar
+		if err := json.Unmarshal([]byte(`{"name": "test"}`), &cfg); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if cfg.Timeout.IsSome() || cfg.Enabled.IsSome() || cfg.Label.IsSome() {
+			t.Errorf("Expected all omitted fields to be None, got %+v", cfg)
+		}
+	})
+
+	t.Run("omitted object field is None", func(t *testing.T) {
+		var cfg jsonConfigObject
+		if err := json.Unmarshal([]byte(`{"name": "test"}`), &cfg); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if cfg.Address.IsSome() {
+			t.Error("Expected Address to be None when field is omitted")
+		}
+	})
+}
+
+func TestJSONUnmarshalPresent(t *testing.T) {
+	t.Run("present scalar fields are Some", func(t *testing.T) {
+		var cfg jsonConfigScalar
+		data := []byte(`{"name": "test", "timeout": 0, "enabled": false, "label": ""}`)
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if val, err := cfg.Timeout.Unwrap(); err != nil || val != 0 {
+			t.Errorf("Expected Timeout Some(0), got %v", cfg.Timeout)
+		}
+		if val, err := cfg.Enabled.Unwrap(); err != nil || val != false {
+			t.Errorf("Expected Enabled Some(false), got %v", cfg.Enabled)
+		}
+		if val, err := cfg.Label.Unwrap(); err != nil || val != "" {
+			t.Errorf("Expected Label Some(\"\"), got %v", cfg.Label)
+		}
+	})
+
+	t.Run("present object with missing nested field", func(t *testing.T) {
+		var cfg jsonConfigObject
+		data := []byte(`{"name": "test", "address": {"street": "123 Main"}}`)
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		addr, err := cfg.Address.Unwrap()
+		if err != nil {
+			t.Fatal("Expected Address to be Some when field is present")
+		}
+		if addr.Street != "123 Main" {
+			t.Errorf("Expected street '123 Main', got %q", addr.Street)
+		}
+		if addr.PostalCode.IsSome() {
+			t.Error("Expected PostalCode to be None when field is omitted")
+		}
+	})
+
+	t.Run("type mismatch returns error", func(t *testing.T) {
+		var cfg jsonConfigScalar
+		if err := json.Unmarshal([]byte(`{"timeout": "soon"}`), &cfg); err == nil {
+			t.Error("Expected error when unmarshalling string into Maybe[int]")
+		}
+	})
+}
+
+func TestJSONUnmarshalNull(t *testing.T) {
+	t.Run("null on non-pointer is None", func(t *testing.T) {
+		cfg := jsonConfigScalar{Timeout: Some(5)}
+		if err := json.Unmarshal([]byte(`{"timeout": null}`), &cfg); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if cfg.Timeout.IsSome() {
+			t.Error("Expected Timeout to be None when field is explicitly null")
+		}
+	})
+
+	t.Run("null on object is None", func(t *testing.T) {
+		var cfg jsonConfigObject
+		if err := json.Unmarshal([]byte(`{"address": null}`), &cfg); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if cfg.Address.IsSome() {
+			t.Error("Expected Address to be None when field is explicitly null")
+		}
+	})
+
+	t.Run("null on pointer is Some(nil)", func(t *testing.T) {
+		var cfg jsonConfigPointer
+		if err := json.Unmarshal([]byte(`{"val": null}`), &cfg); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		val, err := cfg.Val.Unwrap()
+		if err != nil {
+			t.Fatal("Expected Val to be Some when field is explicitly null for pointer type")
+		}
+		if val != nil {
+			t.Errorf("Expected Val to be nil, got %v", val)
+		}
+	})
+
+	t.Run("value on pointer is Some(ptr)", func(t *testing.T) {
+		var cfg jsonConfigPointer
+		if err := json.Unmarshal([]byte(`{"val": 7}`), &cfg); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		val, err := cfg.Val.Unwrap()
+		if err != nil || val == nil || *val != 7 {
+			t.Errorf("Expected Val to be Some(&7), got %v", cfg.Val)
+		}
+	})
+}
+
+func TestJSONMarshal(t *testing.T) {
+	t.Run("Some values are written", func(t *testing.T) {
+		cfg := jsonConfigScalar{Name: "test", Timeout: Some(42), Enabled: Some(true), Label: Some("")}
+		data, err := json.Marshal(cfg)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		expected := `{"name":"test","timeout":42,"enabled":true,"label":""}`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, string(data))
+		}
+	})
+
+	t.Run("None values are omitted with omitzero", func(t *testing.T) {
+		cfg := jsonConfigScalar{Name: "test"}
+		data, err := json.Marshal(cfg)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		expected := `{"name":"test"}`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, string(data))
+		}
+	})
+
+	t.Run("Some object is written", func(t *testing.T) {
+		cfg := jsonConfigObject{Name: "test", Address: Some(jsonAddress{Street: "123 Main"})}
+		data, err := json.Marshal(cfg)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		expected := `{"name":"test","address":{"street":"123 Main"}}`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, string(data))
+		}
+	})
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	in := jsonConfigObject{
+		Name:    "test",
+		Address: Some(jsonAddress{Street: "123 Main", PostalCode: Some("10001")}),
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var out jsonConfigObject
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if out != in {
+		t.Errorf("Expected %+v, got %+v", in, out)
+	}
+}
+
+func TestJSONMarshalNoneAsNull(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{"top-level None", None[int](), `null`},
+		{"top-level Some", Some(1), `1`},
+		{"slice", []Maybe[int]{Some(1), None[int](), Some(3)}, `[1,null,3]`},
+		{"map values", map[string]Maybe[int]{"a": Some(1), "b": None[int]()}, `{"a":1,"b":null}`},
+		{"field without omitzero", jsonConfigPointer{}, `{"val":null}`},
+		{"field with omitzero", jsonConfigScalar{Name: "x"}, `{"name":"x"}`},
+		{"nested Some(None)", Some(None[int]()), `null`},
+		{"nested Some(Some)", Some(Some(2)), `2`},
+		{"slice of nested", []Maybe[Maybe[int]]{None[Maybe[int]](), Some(None[int]()), Some(Some(3))}, `[null,null,3]`},
+		{"Some(nil) pointer", Some[*int](nil), `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+func TestJSONSliceRoundTrip(t *testing.T) {
+	in := []Maybe[int]{Some(1), None[int](), Some(0)}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var out []Maybe[int]
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(out) != len(in) {
+		t.Fatalf("Expected %d elements, got %d", len(in), len(out))
+	}
+	for i := range in {
+		if out[i] != in[i] {
+			t.Errorf("Element %d: expected %v, got %v", i, in[i], out[i])
+		}
+	}
+}
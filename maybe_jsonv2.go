@@ -5,42 +5,92 @@ package maybe
 import (
 	"encoding/json/jsontext"
 	"encoding/json/v2"
-	"reflect"
+	"errors"
 )
 
 // MarshalJSONTo implements jsontext.MarshalerTo interface for maximum performance.
 // Writes directly to the encoder without intermediate buffer allocations.
+// None values are written as null; use the 'omitzero' tag to omit them instead.
 func (m Maybe[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
 	if !m.hasValue {
-		// When used with omitzero, this shouldn't be called for None values.
-		// If called directly, output nothing.
-		return nil
+		// When used with omitzero, this isn't called for None values.
+		// Anywhere else a value is required, so output null.
+		return enc.WriteToken(jsontext.Null)
 	}
 	return json.MarshalEncode(enc, m.value)
 }
 
+// NullPolicy selects how UnmarshalJSONFrom treats a JSON null.
+type NullPolicy int
+
+const (
+	// NullDefault makes null None for non-pointer types and Some(nil) for pointer types.
+	NullDefault NullPolicy = iota
+	// NullIsNone makes null None for every type.
+	NullIsNone
+	// NullIsSomeZero makes null Some of the zero value for every type.
+	NullIsSomeZero
+	// RejectNull makes null an error.
+	RejectNull
+)
+
+// ErrNullRejected is reported when a null is decoded with the RejectNull policy.
+var ErrNullRejected = errors.New("maybe: null is not allowed")
+
+// nullPolicyUnmarshaler is implemented by *Maybe[T].
+type nullPolicyUnmarshaler interface {
+	unmarshalJSONFrom(dec *jsontext.Decoder, policy NullPolicy) error
+}
+
+// NullPolicyUnmarshalers returns unmarshalers that decode every Maybe with
+// the given null policy. Use json.JoinUnmarshalers to combine them with other
+// unmarshalers.
+func NullPolicyUnmarshalers(policy NullPolicy) *json.Unmarshalers {
+	return json.UnmarshalFromFunc(func(dec *jsontext.Decoder, m nullPolicyUnmarshaler) error {
+		return m.unmarshalJSONFrom(dec, policy)
+	})
+}
+
+// WithNullPolicy returns an option for a single decode call that decodes
+// every Maybe with the given null policy:
+//
+//	json.Unmarshal(data, &cfg, maybe.WithNullPolicy(maybe.RejectNull))
+func WithNullPolicy(policy NullPolicy) json.Options {
+	return json.WithUnmarshalers(NullPolicyUnmarshalers(policy))
+}
+
 // UnmarshalJSONFrom implements jsontext.UnmarshalerFrom interface.
-// Sets hasValue=true when any JSON token is encountered for this field.
+// Sets hasValue=true when any JSON token is encountered for this field,
+// except for null, which follows the NullDefault policy.
 func (m *Maybe[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
-	var ptr *T
-	if err := json.UnmarshalDecode(dec, &ptr); err != nil {
-		return err
-	}
-	if ptr == nil {
-		// Check if T is a pointer type
-		var zero T
-		if reflect.TypeOf(&zero).Elem().Kind() == reflect.Pointer {
-			// For pointer types, null means Some(nil)
-			m.hasValue = true
-			m.value = zero
-		} else {
-			// For non-pointer types, null means None
-			m.hasValue = false
-			m.value = zero
+	return m.unmarshalJSONFrom(dec, NullDefault)
+}
+
+func (m *Maybe[T]) unmarshalJSONFrom(dec *jsontext.Decoder, policy NullPolicy) error {
+	if dec.PeekKind() == 'n' {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		switch policy {
+		case NullIsNone:
+			*m = None[T]()
+		case NullIsSomeZero:
+			var zero T
+			*m = Some(zero)
+		case RejectNull:
+			return ErrNullRejected
+		default:
+			m.setNull()
 		}
 		return nil
 	}
+	// Reset the value rather than merging into the previous one, and decode
+	// in place to avoid allocating a temporary for every element.
+	*m = None[T]()
+	if err := json.UnmarshalDecode(dec, &m.value); err != nil {
+		*m = None[T]()
+		return err
+	}
 	m.hasValue = true
-	m.value = *ptr
 	return nil
 }
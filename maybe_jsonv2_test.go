@@ -4,6 +4,8 @@ package maybe
 
 import (
 	"encoding/json/v2"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -259,3 +261,121 @@ func TestMarshalNoneNestedObject(t *testing.T) {
 		t.Errorf("Expected %s, got %s", expected, string(data))
 	}
 }
+
+type TestConfigNullPolicy struct {
+	Count   Maybe[int]         `json:"count"`
+	Ptr     Maybe[*int]        `json:"ptr"`
+	Address Maybe[TestAddress] `json:"address"`
+}
+
+func TestUnmarshalNullPolicy(t *testing.T) {
+	jsonData := []byte(`{"count": null, "ptr": null, "address": {"street": "1 Main", "city": null, "postalcode": null}}`)
+
+	t.Run("NullDefault", func(t *testing.T) {
+		var cfg TestConfigNullPolicy
+		if err := json.Unmarshal(jsonData, &cfg, WithNullPolicy(NullDefault)); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if cfg.Count.IsSome() {
+			t.Error("Expected Count to be None")
+		}
+		if cfg.Ptr.IsNone() {
+			t.Error("Expected Ptr to be Some(nil)")
+		}
+	})
+
+	t.Run("NullIsNone", func(t *testing.T) {
+		var cfg TestConfigNullPolicy
+		if err := json.Unmarshal(jsonData, &cfg, WithNullPolicy(NullIsNone)); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if cfg.Count.IsSome() {
+			t.Error("Expected Count to be None")
+		}
+		if cfg.Ptr.IsSome() {
+			t.Error("Expected Ptr to be None")
+		}
+		if addr := cfg.Address.UnwrapUnsafe(); addr.PostalCode.IsSome() {
+			t.Error("Expected nested PostalCode to be None")
+		}
+	})
+
+	t.Run("NullIsSomeZero", func(t *testing.T) {
+		var cfg TestConfigNullPolicy
+		if err := json.Unmarshal(jsonData, &cfg, WithNullPolicy(NullIsSomeZero)); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if cfg.Count != Some(0) {
+			t.Errorf("Expected Count to be Some(0), got %v", cfg.Count)
+		}
+		if val, err := cfg.Ptr.Unwrap(); err != nil || val != nil {
+			t.Errorf("Expected Ptr to be Some(nil), got %v", cfg.Ptr)
+		}
+		if addr := cfg.Address.UnwrapUnsafe(); addr.PostalCode != Some("") {
+			t.Errorf("Expected nested PostalCode to be Some(\"\"), got %v", addr.PostalCode)
+		}
+	})
+
+	t.Run("RejectNull", func(t *testing.T) {
+		var cfg TestConfigNullPolicy
+		err := json.Unmarshal(jsonData, &cfg, WithNullPolicy(RejectNull))
+		if !errors.Is(err, ErrNullRejected) {
+			t.Errorf("Expected ErrNullRejected, got %v", err)
+		}
+		if err := json.Unmarshal([]byte(`{"count": 1}`), &cfg, WithNullPolicy(RejectNull)); err != nil {
+			t.Errorf("Expected non-null values to be accepted, got %v", err)
+		}
+	})
+
+	t.Run("joined with other unmarshalers", func(t *testing.T) {
+		called := false
+		other := json.UnmarshalFunc(func(data []byte, s *string) error {
+			called = true
+			*s = string(data)
+			return nil
+		})
+		var cfg TestConfigNullPolicy
+		opts := json.WithUnmarshalers(json.JoinUnmarshalers(NullPolicyUnmarshalers(RejectNull), other))
+		if err := json.Unmarshal([]byte(`{"address": {"street": "x"}}`), &cfg, opts); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if !called {
+			t.Error("Expected the joined unmarshaler to be called")
+		}
+		if err := json.Unmarshal([]byte(`{"count": null}`), &cfg, opts); !errors.Is(err, ErrNullRejected) {
+			t.Errorf("Expected ErrNullRejected, got %v", err)
+		}
+	})
+}
+
+func TestUnmarshalDoesNotMerge(t *testing.T) {
+	cfg := TestConfigObject{Address: Some(TestAddress{Street: "old", PostalCode: Some("1")})}
+	if err := json.Unmarshal([]byte(`{"address": {"city": "NYC"}}`), &cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if addr := cfg.Address.UnwrapUnsafe(); addr != (TestAddress{City: "NYC"}) {
+		t.Errorf("Expected address to be replaced, got %+v", addr)
+	}
+}
+
+func BenchmarkUnmarshalMaybeArray(b *testing.B) {
+	data := []byte("[" + strings.Repeat("1,null,", 5000) + "1]")
+	b.ReportAllocs()
+	for b.Loop() {
+		var values []Maybe[int]
+		if err := json.Unmarshal(data, &values); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestMarshalNoneAsNullV2(t *testing.T) {
+	data, err := json.Marshal(map[string][]Maybe[int]{"values": {Some(1), None[int]()}})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	expected := `{"values":[1,null]}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, string(data))
+	}
+}
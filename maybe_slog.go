@@ -0,0 +1,110 @@
+package maybe
+
+import (
+	"context"
+	"log/slog"
+)
+
+// NoneLogValue returns the value logged for None, which logs as <none> with
+// slog.TextHandler and null with slog.JSONHandler. Use NoneValueHandler or
+// NoneValueAttr to log None as something else.
+func NoneLogValue() slog.Value {
+	return noneLogValue
+}
+
+var noneLogValue = slog.AnyValue(noneLog{})
+
+type noneLog struct{}
+
+func (noneLog) String() string               { return "<none>" }
+func (noneLog) MarshalText() ([]byte, error) { return []byte("<none>"), nil }
+func (noneLog) MarshalJSON() ([]byte, error) { return []byte("null"), nil }
+
+// LogValue implements slog.LogValuer interface.
+// Some logs as the value, with nested LogValuers resolved; None logs as NoneLogValue().
+func (m Maybe[T]) LogValue() slog.Value {
+	if !m.hasValue {
+		return noneLogValue
+	}
+	return slog.AnyValue(m.value).Resolve()
+}
+
+// ElideNoneAttr is a ReplaceAttr function for slog.HandlerOptions that drops
+// attributes logged as NoneLogValue().
+func ElideNoneAttr(groups []string, a slog.Attr) slog.Attr {
+	if a.Value.Equal(noneLogValue) {
+		return slog.Attr{}
+	}
+	return a
+}
+
+// NoneValueAttr returns a ReplaceAttr function for slog.HandlerOptions that
+// logs attributes holding None as v instead of NoneLogValue().
+func NoneValueAttr(v slog.Value) func(groups []string, a slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if a.Value.Equal(noneLogValue) {
+			a.Value = v
+		}
+		return a
+	}
+}
+
+// ElideNoneHandler wraps h so that attributes holding a None Maybe are
+// dropped before they reach h, including attributes inside groups.
+func ElideNoneHandler(h slog.Handler) slog.Handler {
+	return noneHandler{Handler: h}
+}
+
+// NoneValueHandler wraps h so that attributes holding a None Maybe reach h
+// as v, including attributes inside groups.
+func NoneValueHandler(h slog.Handler, v slog.Value) slog.Handler {
+	return noneHandler{Handler: h, value: Some(v)}
+}
+
+// noneHandler replaces None attributes with value, or drops them when value
+// is None.
+type noneHandler struct {
+	slog.Handler
+	value Maybe[slog.Value]
+}
+
+func (h noneHandler) Handle(ctx context.Context, r slog.Record) error {
+	filtered := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		if a, ok := h.replace(a); ok {
+			filtered.AddAttrs(a)
+		}
+		return true
+	})
+	return h.Handler.Handle(ctx, filtered)
+}
+
+func (h noneHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return noneHandler{h.Handler.WithAttrs(h.replaceAll(attrs)), h.value}
+}
+
+func (h noneHandler) WithGroup(name string) slog.Handler {
+	return noneHandler{h.Handler.WithGroup(name), h.value}
+}
+
+func (h noneHandler) replaceAll(attrs []slog.Attr) []slog.Attr {
+	filtered := make([]slog.Attr, 0, len(attrs))
+	for _, a := range attrs {
+		if a, ok := h.replace(a); ok {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered
+}
+
+func (h noneHandler) replace(a slog.Attr) (slog.Attr, bool) {
+	switch a.Value.Kind() {
+	case slog.KindLogValuer:
+		if n, ok := a.Value.LogValuer().(interface{ IsNone() bool }); ok && n.IsNone() {
+			return slog.Attr{Key: a.Key, Value: h.value.value}, h.value.hasValue
+		}
+	case slog.KindGroup:
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(h.replaceAll(a.Value.Group())...)}, true
+	}
+	return a, true
+}
@@ -0,0 +1,128 @@
+package maybe
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// secret implements slog.LogValuer to check that nested LogValuers are resolved.
+type secret string
+
+func (secret) LogValue() slog.Value { return slog.StringValue("***") }
+
+// newTestTextHandler returns a TextHandler without time and level, applying replace to other attributes.
+func newTestTextHandler(buf *bytes.Buffer, replace func([]string, slog.Attr) slog.Attr) slog.Handler {
+	return slog.NewTextHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			if replace != nil {
+				return replace(groups, a)
+			}
+			return a
+		},
+	})
+}
+
+func logLine(h slog.Handler, buf *bytes.Buffer, log func(*slog.Logger)) string {
+	log(slog.New(h))
+	return strings.TrimSpace(buf.String())
+}
+
+func TestLogValue(t *testing.T) {
+	t.Run("Some logs the value", func(t *testing.T) {
+		var buf bytes.Buffer
+		got := logLine(newTestTextHandler(&buf, nil), &buf, func(l *slog.Logger) {
+			l.Info("msg", "port", Some(8080), "name", Some("db"))
+		})
+		if expected := `msg=msg port=8080 name=db`; got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("None logs the sentinel", func(t *testing.T) {
+		var buf bytes.Buffer
+		got := logLine(newTestTextHandler(&buf, nil), &buf, func(l *slog.Logger) {
+			l.Info("msg", "port", None[int]())
+		})
+		if expected := `msg=msg port=<none>`; got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("None logs as null in JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		slog.New(slog.NewJSONHandler(&buf, nil)).Info("msg", "port", None[int]())
+		if !strings.Contains(buf.String(), `"port":null`) {
+			t.Errorf("Expected port to be null, got %s", buf.String())
+		}
+	})
+
+	t.Run("nested LogValuers are resolved", func(t *testing.T) {
+		v := Some(secret("hunter2")).LogValue()
+		if v.Kind() != slog.KindString || v.String() != "***" {
+			t.Errorf("Expected resolved value ***, got %v", v)
+		}
+	})
+
+	t.Run("NoneLogValue is the None value", func(t *testing.T) {
+		if !None[int]().LogValue().Equal(NoneLogValue()) {
+			t.Errorf("Expected None to log as NoneLogValue(), got %v", None[int]().LogValue())
+		}
+	})
+}
+
+func TestElideNoneAttr(t *testing.T) {
+	var buf bytes.Buffer
+	got := logLine(newTestTextHandler(&buf, ElideNoneAttr), &buf, func(l *slog.Logger) {
+		l.Info("msg", "port", None[int](), slog.Group("db", "user", None[string](), "host", Some("h")))
+	})
+	if expected := `msg=msg db.host=h`; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestElideNoneHandler(t *testing.T) {
+	var buf bytes.Buffer
+	got := logLine(ElideNoneHandler(newTestTextHandler(&buf, nil)), &buf, func(l *slog.Logger) {
+		l = l.With("region", None[string](), "zone", Some("a")).WithGroup("req")
+		l.Info("msg", "id", Some(1), "user", None[string](), slog.Group("db", "pool", None[int]()))
+	})
+	if expected := `msg=msg zone=a req.id=1`; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestNoneValueAttr(t *testing.T) {
+	var buf bytes.Buffer
+	got := logLine(newTestTextHandler(&buf, NoneValueAttr(slog.StringValue("-"))), &buf, func(l *slog.Logger) {
+		l.Info("msg", "port", None[int](), slog.Group("db", "user", None[string](), "host", Some("h")))
+	})
+	if expected := `msg=msg port=- db.user=- db.host=h`; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestNoneValueHandler(t *testing.T) {
+	var buf bytes.Buffer
+	got := logLine(NoneValueHandler(newTestTextHandler(&buf, nil), slog.StringValue("-")), &buf, func(l *slog.Logger) {
+		l = l.With("region", None[string](), "zone", Some("a")).WithGroup("req")
+		l.Info("msg", "id", Some(1), "user", None[string](), slog.Group("db", "pool", None[int]()))
+	})
+	if expected := `msg=msg region=- zone=a req.id=1 req.user=- req.db.pool=-`; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	t.Run("handlers do not share the value", func(t *testing.T) {
+		var buf bytes.Buffer
+		got := logLine(newTestTextHandler(&buf, nil), &buf, func(l *slog.Logger) {
+			l.Info("msg", "port", None[int]())
+		})
+		if expected := `msg=msg port=<none>`; got != expected {
+			t.Errorf("Expected %q, got %q", expected, got)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package maybe
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements sql.Scanner interface.
+// SQL NULL becomes None; any other value is converted into T, using T's own
+// Scanner when it implements one, and Some is set.
+func (m *Maybe[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*m = FromSQLNull(n)
+	return nil
+}
+
+// Value implements driver.Valuer interface.
+// None becomes SQL NULL; Some uses T's own Valuer when it implements one,
+// otherwise the driver's default conversion.
+func (m Maybe[T]) Value() (driver.Value, error) {
+	return m.ToSQLNull().Value()
+}
+
+func FromSQLNull[T any](n sql.Null[T]) Maybe[T] {
+	if !n.Valid {
+		return None[T]()
+	}
+	return Some(n.V)
+}
+
+func (m Maybe[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     m.value,
+		Valid: m.hasValue,
+	}
+}
@@ -0,0 +1,201 @@
+package maybe
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// fakeConnector is an in-process database/sql driver that stores every
+// executed statement's arguments as a row and returns all stored rows from
+// any query.
+type fakeConnector struct {
+	rows [][]driver.Value
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) { return &fakeConn{c}, nil }
+func (c *fakeConnector) Driver() driver.Driver                        { return fakeDriver{} }
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return nil, errors.New("use fakeConnector") }
+
+type fakeConn struct{ c *fakeConnector }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return &fakeStmt{c.c}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ c *fakeConnector }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.c.rows = append(s.c.rows, args)
+	return driver.RowsAffected(1), nil
+}
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{rows: s.c.rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	next int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+func (r *fakeRows) Close() error { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
+
+// csvList implements its own Scanner and Valuer.
+type csvList []string
+
+func (l csvList) Value() (driver.Value, error) {
+	return strings.Join(l, ","), nil
+}
+
+func (l *csvList) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return errors.New("csvList: expected string")
+	}
+	*l = strings.Split(s, ",")
+	return nil
+}
+
+func TestSQLValue(t *testing.T) {
+	t.Run("None is NULL", func(t *testing.T) {
+		v, err := None[int]().Value()
+		if err != nil || v != nil {
+			t.Errorf("Expected nil, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("Some uses default conversion", func(t *testing.T) {
+		v, err := Some(int32(42)).Value()
+		if err != nil || v != int64(42) {
+			t.Errorf("Expected int64(42), got %#v (%v)", v, err)
+		}
+	})
+
+	t.Run("Some delegates to element Valuer", func(t *testing.T) {
+		v, err := Some(csvList{"a", "b"}).Value()
+		if err != nil || v != "a,b" {
+			t.Errorf("Expected 'a,b', got %#v (%v)", v, err)
+		}
+	})
+
+	t.Run("unsupported element type returns error", func(t *testing.T) {
+		if _, err := Some(struct{}{}).Value(); err == nil {
+			t.Error("Expected error for unsupported element type")
+		}
+	})
+}
+
+func TestSQLScan(t *testing.T) {
+	t.Run("NULL is None", func(t *testing.T) {
+		m := Some(1)
+		if err := m.Scan(nil); err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		if m.IsSome() {
+			t.Error("Expected None after scanning NULL")
+		}
+	})
+
+	t.Run("value is converted", func(t *testing.T) {
+		var m Maybe[int]
+		if err := m.Scan([]byte("17")); err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		if m != Some(17) {
+			t.Errorf("Expected Some(17), got %v", m)
+		}
+	})
+
+	t.Run("conversion error is returned", func(t *testing.T) {
+		var m Maybe[int]
+		if err := m.Scan("abc"); err == nil {
+			t.Error("Expected error converting 'abc' to int")
+		}
+	})
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	db := sql.OpenDB(&fakeConnector{})
+	defer db.Close()
+
+	inserts := [][]any{
+		{Some(int64(1)), Some("one"), Some(csvList{"a", "b"})},
+		{None[int64](), None[string](), None[csvList]()},
+	}
+	for _, args := range inserts {
+		if _, err := db.Exec("INSERT", args...); err != nil {
+			t.Fatalf("Exec failed: %v", err)
+		}
+	}
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	defer rows.Close()
+
+	var got [][3]string
+	for rows.Next() {
+		var id Maybe[int64]
+		var name Maybe[string]
+		var tags Maybe[csvList]
+		if err := rows.Scan(&id, &name, &tags); err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		got = append(got, [3]string{id.String(), name.String(), Map(tags, func(l csvList) string { return strings.Join(l, "|") }).String()})
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("Rows failed: %v", err)
+	}
+
+	expected := [][3]string{
+		{"Some[int64](1)", "Some[string](one)", "Some[string](a|b)"},
+		{"None[int64]()", "None[string]()", "None[string]()"},
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d rows, got %d", len(expected), len(got))
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Row %d: expected %v, got %v", i, expected[i], got[i])
+		}
+	}
+}
+
+func TestSQLNullConversions(t *testing.T) {
+	if got := FromSQLNull(sql.Null[int]{V: 3, Valid: true}); got != Some(3) {
+		t.Errorf("Expected Some(3), got %v", got)
+	}
+	if got := FromSQLNull(sql.Null[int]{V: 3}); got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+	if got := Some(3).ToSQLNull(); got != (sql.Null[int]{V: 3, Valid: true}) {
+		t.Errorf("Expected valid Null, got %v", got)
+	}
+	if got := None[int]().ToSQLNull(); got.Valid {
+		t.Errorf("Expected invalid Null, got %v", got)
+	}
+}
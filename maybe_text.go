@@ -0,0 +1,51 @@
+package maybe
+
+// MarshalText implements encoding.TextMarshaler interface.
+// None is written as empty text; Some uses T's own MarshalText,
+// time.Duration's String (as in "1m30s"), or strconv formatting for basic
+// kinds.
+func (m Maybe[T]) MarshalText() ([]byte, error) {
+	if !m.hasValue {
+		return []byte{}, nil
+	}
+	return marshalText(m.value)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+// Empty input is None (see EmptyAsValue); other input is parsed with T's
+// own UnmarshalText, time.ParseDuration for time.Duration, or strconv for
+// basic kinds.
+func (m *Maybe[T]) UnmarshalText(text []byte) error {
+	return m.unmarshalText(text, false)
+}
+
+func (m *Maybe[T]) unmarshalText(text []byte, emptyAsValue bool) error {
+	if len(text) == 0 && !emptyAsValue {
+		*m = None[T]()
+		return nil
+	}
+	var value T
+	if err := unmarshalText(text, &value); err != nil {
+		return err
+	}
+	*m = Some(value)
+	return nil
+}
+
+// EmptyAsValue is a Maybe that decodes empty text as a value rather than
+// None, so Maybe[string] becomes Some("") and types that cannot parse empty
+// text return an error. Use it for fields where empty text is meaningful:
+//
+//	type Config struct {
+//		Suffix maybe.EmptyAsValue[string] `toml:"suffix"`
+//	}
+//
+// Note that MarshalText writes both None and Some("") as empty text.
+type EmptyAsValue[T any] struct {
+	Maybe[T]
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (m *EmptyAsValue[T]) UnmarshalText(text []byte) error {
+	return m.Maybe.unmarshalText(text, true)
+}
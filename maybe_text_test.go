@@ -0,0 +1,161 @@
+package maybe
+
+import (
+	"encoding"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+var (
+	_ encoding.TextMarshaler   = Maybe[int]{}
+	_ encoding.TextUnmarshaler = (*Maybe[int])(nil)
+)
+
+func TestMarshalText(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        encoding.TextMarshaler
+		expected string
+	}{
+		{"None", None[int](), ""},
+		{"int", Some(-42), "-42"},
+		{"uint8", Some(uint8(255)), "255"},
+		{"float32", Some(float32(1.5)), "1.5"},
+		{"bool", Some(true), "true"},
+		{"string", Some("hello"), "hello"},
+		{"TextMarshaler", Some(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)), "2024-01-02T03:04:05Z"},
+		{"named basic kind", Some(time.March), "3"},
+		{"Duration", Some(90 * time.Second), "1m30s"},
+		{"Duration without unit", Some(time.Duration(5)), "5ns"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.m.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText failed: %v", err)
+			}
+			if string(text) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, text)
+			}
+		})
+	}
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		if _, err := Some([]int{1}).MarshalText(); err == nil {
+			t.Error("Expected error for unsupported kind")
+		}
+	})
+
+	t.Run("pointer round trip", func(t *testing.T) {
+		n := 7
+		text, err := Some(&n).MarshalText()
+		if err != nil || string(text) != "7" {
+			t.Fatalf("Expected \"7\", got %q (%v)", text, err)
+		}
+		var m Maybe[*int]
+		if err := m.UnmarshalText(text); err != nil {
+			t.Fatalf("UnmarshalText failed: %v", err)
+		}
+		if v, err := m.Unwrap(); err != nil || v == nil || *v != 7 {
+			t.Errorf("Expected Some(&7), got %v", m)
+		}
+	})
+
+	t.Run("nil pointer returns error", func(t *testing.T) {
+		if _, err := Some[*int](nil).MarshalText(); err == nil {
+			t.Error("Expected error for a nil pointer")
+		}
+	})
+}
+
+func TestUnmarshalText(t *testing.T) {
+	t.Run("basic kinds", func(t *testing.T) {
+		var i Maybe[int8]
+		if err := i.UnmarshalText([]byte("-8")); err != nil || i != Some(int8(-8)) {
+			t.Errorf("Expected Some(-8), got %v (%v)", i, err)
+		}
+		var u Maybe[uint]
+		if err := u.UnmarshalText([]byte("8")); err != nil || u != Some(uint(8)) {
+			t.Errorf("Expected Some(8), got %v (%v)", u, err)
+		}
+		var f Maybe[float64]
+		if err := f.UnmarshalText([]byte("2.5")); err != nil || f != Some(2.5) {
+			t.Errorf("Expected Some(2.5), got %v (%v)", f, err)
+		}
+		var b Maybe[bool]
+		if err := b.UnmarshalText([]byte("false")); err != nil || b != Some(false) {
+			t.Errorf("Expected Some(false), got %v (%v)", b, err)
+		}
+		var s Maybe[string]
+		if err := s.UnmarshalText([]byte("hi")); err != nil || s != Some("hi") {
+			t.Errorf("Expected Some(hi), got %v (%v)", s, err)
+		}
+	})
+
+	t.Run("Duration", func(t *testing.T) {
+		var d Maybe[time.Duration]
+		if err := d.UnmarshalText([]byte("1m30s")); err != nil || d != Some(90*time.Second) {
+			t.Errorf("Expected Some(1m30s), got %v (%v)", d, err)
+		}
+		if err := d.UnmarshalText([]byte("30")); err == nil {
+			t.Error("Expected error for a duration without a unit")
+		}
+	})
+
+	t.Run("TextUnmarshaler", func(t *testing.T) {
+		var addr Maybe[netip.Addr]
+		if err := addr.UnmarshalText([]byte("10.0.0.1")); err != nil {
+			t.Fatalf("UnmarshalText failed: %v", err)
+		}
+		if addr != Some(netip.MustParseAddr("10.0.0.1")) {
+			t.Errorf("Expected Some(10.0.0.1), got %v", addr)
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		var i Maybe[int8]
+		if err := i.UnmarshalText([]byte("300")); err == nil {
+			t.Error("Expected error for out of range int8")
+		}
+		var b Maybe[bool]
+		if err := b.UnmarshalText([]byte("maybe")); err == nil {
+			t.Error("Expected error for invalid bool")
+		}
+		var unsupported Maybe[[]int]
+		if err := unsupported.UnmarshalText([]byte("1")); err == nil {
+			t.Error("Expected error for unsupported kind")
+		}
+	})
+
+	t.Run("empty input is None", func(t *testing.T) {
+		s := Some("x")
+		if err := s.UnmarshalText(nil); err != nil || s.IsSome() {
+			t.Errorf("Expected None, got %v (%v)", s, err)
+		}
+	})
+
+	t.Run("empty input with EmptyAsValue", func(t *testing.T) {
+		var s EmptyAsValue[string]
+		if err := s.UnmarshalText([]byte{}); err != nil || s.Maybe != Some("") {
+			t.Errorf("Expected Some(\"\"), got %v (%v)", s, err)
+		}
+		if err := s.UnmarshalText([]byte("x")); err != nil || s.Maybe != Some("x") {
+			t.Errorf("Expected Some(x), got %v (%v)", s, err)
+		}
+		var i EmptyAsValue[int]
+		if err := i.UnmarshalText([]byte{}); err == nil {
+			t.Error("Expected error parsing empty text as int")
+		}
+	})
+
+	t.Run("pointer element of Maybe", func(t *testing.T) {
+		var m Maybe[*int]
+		if err := m.UnmarshalText([]byte("7")); err != nil {
+			t.Fatalf("UnmarshalText failed: %v", err)
+		}
+		if v, err := m.Unwrap(); err != nil || v == nil || *v != 7 {
+			t.Errorf("Expected Some(&7), got %v", m)
+		}
+	})
+}
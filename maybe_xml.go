@@ -0,0 +1,93 @@
+package maybe
+
+import (
+	"encoding/xml"
+)
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// MarshalXML implements xml.Marshaler interface.
+// None writes nothing, so the element is omitted.
+func (m Maybe[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !m.hasValue {
+		return nil
+	}
+	return e.EncodeElement(m.value, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler interface.
+// Sets hasValue=true when the element is present, including when it is
+// marked xsi:nil="true"; use XMLNillable to decode such elements as None.
+func (m *Maybe[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return m.unmarshalXML(d, start, false)
+}
+
+func (m *Maybe[T]) unmarshalXML(d *xml.Decoder, start xml.StartElement, nilAsNone bool) error {
+	if nilAsNone && isXSINil(start) {
+		*m = None[T]()
+		return d.Skip()
+	}
+	var value T
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	*m = Some(value)
+	return nil
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr interface.
+// None returns an empty attribute, so the attribute is omitted.
+func (m Maybe[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !m.hasValue {
+		return xml.Attr{}, nil
+	}
+	if ma, ok := any(m.value).(xml.MarshalerAttr); ok {
+		return ma.MarshalXMLAttr(name)
+	}
+	text, err := marshalText(m.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr interface.
+// It is only called for attributes that are present, so missing attributes stay None.
+func (m *Maybe[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var value T
+	if ua, ok := any(&value).(xml.UnmarshalerAttr); ok {
+		if err := ua.UnmarshalXMLAttr(attr); err != nil {
+			return err
+		}
+	} else if err := unmarshalText([]byte(attr.Value), &value); err != nil {
+		return err
+	}
+	*m = Some(value)
+	return nil
+}
+
+// XMLNillable is a Maybe that decodes elements marked xsi:nil="true" as None.
+// Use it for the fields of nillable XML schema elements:
+//
+//	type Order struct {
+//		Note maybe.XMLNillable[string] `xml:"note"`
+//	}
+//
+// It encodes like Maybe, so None is omitted.
+type XMLNillable[T any] struct {
+	Maybe[T]
+}
+
+// UnmarshalXML implements xml.Unmarshaler interface.
+func (m *XMLNillable[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return m.Maybe.unmarshalXML(d, start, true)
+}
+
+func isXSINil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package maybe
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+type xmlOrder struct {
+	XMLName  xml.Name           `xml:"order"`
+	ID       Maybe[int]         `xml:"id,attr"`
+	Currency Maybe[string]      `xml:"currency,attr"`
+	Placed   Maybe[time.Time]   `xml:"placed,attr"`
+	Note     Maybe[string]      `xml:"note"`
+	Quantity Maybe[int]         `xml:"quantity"`
+	Ship     Maybe[xmlShipping] `xml:"shipping"`
+}
+
+type xmlShipping struct {
+	City string `xml:"city"`
+}
+
+func TestXMLMarshal(t *testing.T) {
+	t.Run("Some elements and attributes are written", func(t *testing.T) {
+		order := xmlOrder{
+			ID:       Some(7),
+			Note:     Some(""),
+			Quantity: Some(3),
+			Ship:     Some(xmlShipping{City: "NYC"}),
+		}
+		data, err := xml.Marshal(order)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		expected := `<order id="7"><note></note><quantity>3</quantity><shipping><city>NYC</city></shipping></order>`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("None elements and attributes are omitted", func(t *testing.T) {
+		data, err := xml.Marshal(xmlOrder{})
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		expected := `<order></order>`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("TextMarshaler attributes", func(t *testing.T) {
+		placed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		data, err := xml.Marshal(xmlOrder{Placed: Some(placed)})
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		expected := `<order placed="2024-01-02T03:04:05Z"></order>`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+}
+
+func TestXMLUnmarshal(t *testing.T) {
+	t.Run("present and missing values", func(t *testing.T) {
+		data := `<order id="7" placed="2024-01-02T03:04:05Z"><quantity>3</quantity><shipping><city>NYC</city></shipping></order>`
+		var order xmlOrder
+		if err := xml.Unmarshal([]byte(data), &order); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if order.ID != Some(7) {
+			t.Errorf("Expected ID Some(7), got %v", order.ID)
+		}
+		if order.Currency.IsSome() {
+			t.Errorf("Expected Currency None, got %v", order.Currency)
+		}
+		if placed, err := order.Placed.Unwrap(); err != nil || placed.Year() != 2024 {
+			t.Errorf("Expected Placed in 2024, got %v", order.Placed)
+		}
+		if order.Note.IsSome() {
+			t.Errorf("Expected Note None, got %v", order.Note)
+		}
+		if order.Quantity != Some(3) {
+			t.Errorf("Expected Quantity Some(3), got %v", order.Quantity)
+		}
+		if order.Ship != Some(xmlShipping{City: "NYC"}) {
+			t.Errorf("Expected Ship Some({NYC}), got %v", order.Ship)
+		}
+	})
+
+	t.Run("empty element is Some", func(t *testing.T) {
+		var order xmlOrder
+		if err := xml.Unmarshal([]byte(`<order><note/></order>`), &order); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if order.Note != Some("") {
+			t.Errorf("Expected Note Some(\"\"), got %v", order.Note)
+		}
+	})
+
+	t.Run("invalid attribute returns error", func(t *testing.T) {
+		var order xmlOrder
+		if err := xml.Unmarshal([]byte(`<order id="x"></order>`), &order); err == nil {
+			t.Error("Expected error for invalid int attribute")
+		}
+	})
+}
+
+type xmlNillableOrder struct {
+	XMLName  xml.Name            `xml:"order"`
+	Note     XMLNillable[string] `xml:"note"`
+	Quantity XMLNillable[int]    `xml:"quantity"`
+	Comment  XMLNillable[string] `xml:"comment"`
+}
+
+func TestXMLNillable(t *testing.T) {
+	data := []byte(`<order xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><note xsi:nil="true"/><quantity xsi:nil="true"></quantity><comment>hi</comment></order>`)
+
+	t.Run("Maybe decodes nil elements as Some", func(t *testing.T) {
+		var order xmlOrder
+		if err := xml.Unmarshal(data, &order); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if order.Note != Some("") {
+			t.Errorf("Expected Note Some(\"\"), got %v", order.Note)
+		}
+	})
+
+	t.Run("XMLNillable decodes nil elements as None", func(t *testing.T) {
+		order := xmlNillableOrder{Note: XMLNillable[string]{Some("old")}}
+		if err := xml.Unmarshal(data, &order); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if order.Note.IsSome() {
+			t.Errorf("Expected Note None, got %v", order.Note)
+		}
+		if order.Quantity.IsSome() {
+			t.Errorf("Expected Quantity None, got %v", order.Quantity)
+		}
+		if order.Comment.Maybe != Some("hi") {
+			t.Errorf("Expected Comment Some(hi), got %v", order.Comment)
+		}
+	})
+
+	t.Run("XMLNillable encodes like Maybe", func(t *testing.T) {
+		order := xmlNillableOrder{Comment: XMLNillable[string]{Some("hi")}}
+		data, err := xml.Marshal(order)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		expected := `<order><comment>hi</comment></order>`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package maybe
+
+// MarshalYAML implements the Marshaler interface detected by popular YAML
+// libraries (gopkg.in/yaml.v2, gopkg.in/yaml.v3).
+// None is written as null; use the 'omitempty' tag, which honors IsZero, to omit it instead.
+func (m Maybe[T]) MarshalYAML() (any, error) {
+	if !m.hasValue {
+		return nil, nil
+	}
+	return m.value, nil
+}
+
+// UnmarshalYAML implements the Unmarshaler interface detected by popular YAML
+// libraries. The JSON null semantics apply: null on non-pointer types is None
+// and null on pointer types is Some(nil).
+func (m *Maybe[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var ptr *T
+	if err := unmarshal(&ptr); err != nil {
+		return err
+	}
+	if ptr == nil {
+		m.setNull()
+		return nil
+	}
+	m.hasValue = true
+	m.value = *ptr
+	return nil
+}
@@ -0,0 +1,128 @@
+package maybe
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// The YAML hooks are exercised with a small fake of the behaviour of YAML
+// libraries: decoding hands UnmarshalYAML a function that decodes the node
+// into a Go value (JSON stands in for the YAML node here), and encoding calls
+// MarshalYAML and omits 'omitempty' fields whose IsZero reports true.
+
+type yamlUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(any) error) error
+}
+
+type yamlMarshaler interface {
+	MarshalYAML() (any, error)
+}
+
+// fakeYAMLDecode decodes each field of the struct dst points to from the
+// node with the field's yaml name. Missing nodes leave the field untouched.
+func fakeYAMLDecode(nodes map[string]string, dst any) error {
+	rv := reflect.ValueOf(dst).Elem()
+	for i := range rv.NumField() {
+		name, _, _ := strings.Cut(rv.Type().Field(i).Tag.Get("yaml"), ",")
+		node, ok := nodes[name]
+		if !ok {
+			continue
+		}
+		unmarshal := func(v any) error { return json.Unmarshal([]byte(node), v) }
+		if u, ok := rv.Field(i).Addr().Interface().(yamlUnmarshaler); ok {
+			if err := u.UnmarshalYAML(unmarshal); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := unmarshal(rv.Field(i).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fakeYAMLEncode converts the struct v to a map of field values as a YAML
+// library would see them.
+func fakeYAMLEncode(v any) (map[string]any, error) {
+	out := map[string]any{}
+	rv := reflect.ValueOf(v)
+	for i := range rv.NumField() {
+		name, opts, _ := strings.Cut(rv.Type().Field(i).Tag.Get("yaml"), ",")
+		field := rv.Field(i).Interface()
+		if z, ok := field.(interface{ IsZero() bool }); ok && opts == "omitempty" && z.IsZero() {
+			continue
+		}
+		if m, ok := field.(yamlMarshaler); ok {
+			value, err := m.MarshalYAML()
+			if err != nil {
+				return nil, err
+			}
+			field = value
+		}
+		out[name] = field
+	}
+	return out, nil
+}
+
+type yamlConfig struct {
+	Host    string         `yaml:"host"`
+	Port    Maybe[int]     `yaml:"port,omitempty"`
+	Debug   Maybe[bool]    `yaml:"debug"`
+	Proxy   Maybe[*string] `yaml:"proxy"`
+	Comment Maybe[string]  `yaml:"comment,omitempty"`
+}
+
+func TestYAMLUnmarshal(t *testing.T) {
+	t.Run("present values are Some", func(t *testing.T) {
+		var cfg yamlConfig
+		err := fakeYAMLDecode(map[string]string{"host": `"db"`, "port": `0`, "debug": `true`}, &cfg)
+		if err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		if cfg.Port != Some(0) {
+			t.Errorf("Expected Port Some(0), got %v", cfg.Port)
+		}
+		if cfg.Debug != Some(true) {
+			t.Errorf("Expected Debug Some(true), got %v", cfg.Debug)
+		}
+		if cfg.Comment.IsSome() {
+			t.Errorf("Expected Comment None, got %v", cfg.Comment)
+		}
+	})
+
+	t.Run("null semantics", func(t *testing.T) {
+		cfg := yamlConfig{Port: Some(1)}
+		err := fakeYAMLDecode(map[string]string{"port": `null`, "proxy": `null`}, &cfg)
+		if err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		if cfg.Port.IsSome() {
+			t.Errorf("Expected Port None for null, got %v", cfg.Port)
+		}
+		if proxy, err := cfg.Proxy.Unwrap(); err != nil || proxy != nil {
+			t.Errorf("Expected Proxy Some(nil) for null, got %v", cfg.Proxy)
+		}
+	})
+
+	t.Run("errors are returned", func(t *testing.T) {
+		var cfg yamlConfig
+		if err := fakeYAMLDecode(map[string]string{"port": `"eighty"`}, &cfg); err == nil {
+			t.Error("Expected error decoding string into Maybe[int]")
+		}
+	})
+}
+
+func TestYAMLMarshal(t *testing.T) {
+	proxy := "http://proxy"
+	out, err := fakeYAMLEncode(yamlConfig{Host: "db", Port: Some(5432), Proxy: Some(&proxy)})
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	expected := map[string]any{"host": "db", "port": 5432, "debug": nil, "proxy": &proxy}
+	if !reflect.DeepEqual(out, expected) {
+		t.Errorf("Expected %v, got %v", expected, out)
+	}
+}
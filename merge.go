@@ -0,0 +1,278 @@
+package maybe
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MergeStrategy controls how slice and map fields are combined by MergeWith.
+type MergeStrategy uint8
+
+const (
+	// MergeReplace takes the collection from the last layer that sets it.
+	MergeReplace MergeStrategy = iota
+	// MergeAppend concatenates the slices of every layer that sets them.
+	MergeAppend
+	// MergeKeys combines the maps of every layer that sets them, later
+	// layers winning for duplicate keys.
+	MergeKeys
+)
+
+func (s MergeStrategy) String() string {
+	switch s {
+	case MergeReplace:
+		return "replace"
+	case MergeAppend:
+		return "append"
+	case MergeKeys:
+		return "merge"
+	default:
+		return fmt.Sprintf("MergeStrategy(%d)", uint8(s))
+	}
+}
+
+func parseMergeStrategy(text string) (MergeStrategy, error) {
+	for _, s := range []MergeStrategy{MergeReplace, MergeAppend, MergeKeys} {
+		if text == s.String() {
+			return s, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown merge strategy %q", text)
+}
+
+// MergeOptions configures MergeWith.
+type MergeOptions struct {
+	// Slices is the strategy for slice fields without a `merge` tag.
+	Slices MergeStrategy
+	// Maps is the strategy for map fields without a `merge` tag.
+	Maps MergeStrategy
+	// Strategy, if set, is called for slice and map fields without a `merge`
+	// tag. Returning false falls back to Slices or Maps.
+	Strategy func(path string, t reflect.Type) (MergeStrategy, bool)
+}
+
+// MergeReport records, for each field path, the index of the layer its
+// merged value came from. For appended slices and merged maps it is the last
+// layer that contributed. Fields that no layer set are not in the report.
+// Paths use the Go field names, e.g. "Server.Port".
+type MergeReport map[string]int
+
+// Layer returns the index of the layer the field at path came from.
+func (r MergeReport) Layer(path string) Maybe[int] {
+	if layer, ok := r[path]; ok {
+		return Some(layer)
+	}
+	return None[int]()
+}
+
+// Merge combines layers of configuration, such as defaults, a config file,
+// environment variables and flags, into one value. See MergeWith.
+// Merge panics if a `merge` tag is invalid.
+func Merge[T any](layers ...T) T {
+	result, _, err := MergeWith(MergeOptions{}, layers...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// MergeWith combines layers field by field, later layers taking precedence:
+//   - Maybe fields take the last Some value
+//   - struct fields with exported fields are merged recursively, including
+//     the values of Maybe fields
+//   - slice and map fields take the last non-nil (or Some) value, unless a
+//     `merge:"append"` or `merge:"merge"` tag or the options say otherwise
+//   - any other field takes the last non-zero value
+//
+// Unexported fields are left as the zero value, except embedded structs of
+// unexported type, whose exported fields are merged.
+func MergeWith[T any](opts MergeOptions, layers ...T) (T, MergeReport, error) {
+	m := merger{opts: opts, report: MergeReport{}}
+	values := make([]mergeLayer, len(layers))
+	for i := range layers {
+		values[i] = mergeLayer{index: i, value: reflect.ValueOf(&layers[i]).Elem()}
+	}
+	var result T
+	err := m.merge(reflect.ValueOf(&result).Elem(), values, "", "")
+	return result, m.report, err
+}
+
+type mergeLayer struct {
+	index int
+	value reflect.Value
+}
+
+type merger struct {
+	opts   MergeOptions
+	report MergeReport
+}
+
+func (m *merger) merge(dst reflect.Value, layers []mergeLayer, path, tag string) error {
+	t := dst.Type()
+	if IsMaybeType(t) {
+		return m.mergeMaybe(dst, layers, path, tag)
+	}
+	switch {
+	case isMergeStruct(t):
+		return m.mergeStruct(dst, layers, path)
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Map:
+		var set []mergeLayer
+		for _, layer := range layers {
+			if !layer.value.IsNil() {
+				set = append(set, layer)
+			}
+		}
+		return m.mergeCollection(dst, set, path, tag)
+	case tag != "":
+		return fmt.Errorf("maybe: merge tag on field %s of type %s", path, t)
+	}
+	for i := len(layers) - 1; i >= 0; i-- {
+		if !layers[i].value.IsZero() {
+			dst.Set(layers[i].value)
+			m.report[path] = layers[i].index
+			break
+		}
+	}
+	return nil
+}
+
+// mergeMaybe merges the Some values of the layers into dst.
+func (m *merger) mergeMaybe(dst reflect.Value, layers []mergeLayer, path, tag string) error {
+	setter := dst.Addr().Interface().(AnyMaybeSetter)
+	elemType := setter.ElemType()
+	if tag != "" && elemType.Kind() != reflect.Slice && elemType.Kind() != reflect.Map {
+		return fmt.Errorf("maybe: merge tag on field %s of type %s", path, dst.Type())
+	}
+	var somes []mergeLayer
+	for _, layer := range layers {
+		value, ok := layer.value.Interface().(AnyMaybe).AnyValue()
+		if !ok {
+			continue
+		}
+		elem := reflect.New(elemType).Elem()
+		if value != nil {
+			elem.Set(reflect.ValueOf(value))
+		}
+		somes = append(somes, mergeLayer{index: layer.index, value: elem})
+	}
+	if len(somes) == 0 {
+		return nil
+	}
+
+	elem := reflect.New(elemType).Elem()
+	switch {
+	case isMergeStruct(elemType):
+		if err := m.mergeStruct(elem, somes, path); err != nil {
+			return err
+		}
+	case elemType.Kind() == reflect.Slice || elemType.Kind() == reflect.Map:
+		if err := m.mergeCollection(elem, somes, path, tag); err != nil {
+			return err
+		}
+	default:
+		last := somes[len(somes)-1]
+		elem.Set(last.value)
+		m.report[path] = last.index
+	}
+	return setter.SetAny(elem.Interface())
+}
+
+func (m *merger) mergeStruct(dst reflect.Value, layers []mergeLayer, path string) error {
+	t := dst.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		// Embedded structs of unexported type are merged through their
+		// exported fields, which are promoted like in encoding/json.
+		embedded := !field.IsExported() && field.Anonymous && field.Type.Kind() == reflect.Struct
+		if !field.IsExported() && !embedded {
+			continue
+		}
+		fieldPath := path
+		if !field.Anonymous {
+			fieldPath = joinPath(path, field.Name)
+		}
+		fields := make([]mergeLayer, len(layers))
+		for j, layer := range layers {
+			fields[j] = mergeLayer{index: layer.index, value: layer.value.Field(i)}
+		}
+		var err error
+		if embedded {
+			err = m.mergeStruct(dst.Field(i), fields, fieldPath)
+		} else {
+			err = m.merge(dst.Field(i), fields, fieldPath, field.Tag.Get("merge"))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeCollection combines the slices or maps of the layers that set them.
+func (m *merger) mergeCollection(dst reflect.Value, set []mergeLayer, path, tag string) error {
+	strategy, err := m.strategy(dst.Type(), path, tag)
+	if err != nil {
+		return err
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	last := set[len(set)-1]
+	switch strategy {
+	case MergeAppend:
+		merged := reflect.MakeSlice(dst.Type(), 0, 0)
+		for _, layer := range set {
+			merged = reflect.AppendSlice(merged, layer.value)
+		}
+		dst.Set(merged)
+	case MergeKeys:
+		merged := reflect.MakeMap(dst.Type())
+		for _, layer := range set {
+			iter := layer.value.MapRange()
+			for iter.Next() {
+				merged.SetMapIndex(iter.Key(), iter.Value())
+			}
+		}
+		dst.Set(merged)
+	default:
+		dst.Set(last.value)
+	}
+	m.report[path] = last.index
+	return nil
+}
+
+func (m *merger) strategy(t reflect.Type, path, tag string) (MergeStrategy, error) {
+	strategy := m.opts.Maps
+	if t.Kind() == reflect.Slice {
+		strategy = m.opts.Slices
+	}
+	if tag != "" {
+		parsed, err := parseMergeStrategy(tag)
+		if err != nil {
+			return 0, fmt.Errorf("maybe: field %s: %w", path, err)
+		}
+		strategy = parsed
+	} else if m.opts.Strategy != nil {
+		if s, ok := m.opts.Strategy(path, t); ok {
+			strategy = s
+		}
+	}
+	if t.Kind() == reflect.Slice && strategy == MergeKeys || t.Kind() == reflect.Map && strategy == MergeAppend {
+		return 0, fmt.Errorf("maybe: cannot %s field %s of type %s", strategy, path, t)
+	}
+	return strategy, nil
+}
+
+// isMergeStruct reports whether t is a struct that is merged field by field.
+// Structs without exported fields, such as time.Time, are merged as values.
+func isMergeStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || IsMaybeType(t) {
+		return false
+	}
+	for i := range t.NumField() {
+		if field := t.Field(i); field.IsExported() || field.Anonymous && field.Type.Kind() == reflect.Struct {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+package maybe
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type mergeDatabase struct {
+	DSN  Maybe[string]
+	Pool Maybe[int]
+}
+
+type mergeServer struct {
+	Host Maybe[string]
+	Port Maybe[int]
+}
+
+type mergeConfig struct {
+	Name     Maybe[string]
+	Timeout  Maybe[time.Duration]
+	Server   mergeServer
+	Database Maybe[mergeDatabase]
+	Tags     []string
+	Plugins  Maybe[[]string] `merge:"append"`
+	Labels   map[string]string
+	Env      map[string]string `merge:"merge"`
+	Started  time.Time
+	Retries  int
+	internal int
+}
+
+func TestMerge(t *testing.T) {
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	defaults := mergeConfig{
+		Name:     Some("app"),
+		Timeout:  Some(30 * time.Second),
+		Server:   mergeServer{Host: Some("localhost"), Port: Some(8080)},
+		Database: Some(mergeDatabase{Pool: Some(4)}),
+		Tags:     []string{"default"},
+		Plugins:  Some([]string{"core"}),
+		Env:      map[string]string{"A": "1", "B": "1"},
+		Retries:  3,
+		internal: 1,
+	}
+	file := mergeConfig{
+		Server:   mergeServer{Port: Some(9090)},
+		Database: Some(mergeDatabase{DSN: Some("postgres://file")}),
+		Plugins:  Some([]string{"auth"}),
+		Labels:   map[string]string{"team": "core"},
+		Env:      map[string]string{"B": "2"},
+		Started:  started,
+	}
+	flags := mergeConfig{
+		Timeout: Some(time.Duration(0)),
+		Tags:    []string{},
+		Plugins: Some[[]string](nil),
+		Env:     map[string]string{"C": "3"},
+	}
+
+	result, report, err := MergeWith(MergeOptions{}, defaults, file, flags)
+	if err != nil {
+		t.Fatalf("MergeWith failed: %v", err)
+	}
+
+	expected := mergeConfig{
+		Name:     Some("app"),
+		Timeout:  Some(time.Duration(0)),
+		Server:   mergeServer{Host: Some("localhost"), Port: Some(9090)},
+		Database: Some(mergeDatabase{DSN: Some("postgres://file"), Pool: Some(4)}),
+		Tags:     []string{},
+		Plugins:  Some([]string{"core", "auth"}),
+		Labels:   map[string]string{"team": "core"},
+		Env:      map[string]string{"A": "1", "B": "2", "C": "3"},
+		Started:  started,
+		Retries:  3,
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, result)
+	}
+
+	t.Run("report", func(t *testing.T) {
+		expected := MergeReport{
+			"Name":          0,
+			"Timeout":       2,
+			"Server.Host":   0,
+			"Server.Port":   1,
+			"Database.DSN":  1,
+			"Database.Pool": 0,
+			"Tags":          2,
+			"Plugins":       2,
+			"Labels":        1,
+			"Env":           2,
+			"Started":       1,
+			"Retries":       0,
+		}
+		if !maps.Equal(report, expected) {
+			t.Errorf("Expected %v, got %v", expected, report)
+		}
+		if report.Layer("Server.Port") != Some(1) {
+			t.Errorf("Expected Some(1), got %v", report.Layer("Server.Port"))
+		}
+		if report.Layer("missing").IsSome() {
+			t.Error("Expected None for a field no layer set")
+		}
+	})
+
+	t.Run("Merge matches MergeWith", func(t *testing.T) {
+		if got := Merge(defaults, file, flags); !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, got)
+		}
+	})
+
+	t.Run("no layers", func(t *testing.T) {
+		if got := Merge[mergeConfig](); !reflect.DeepEqual(got, mergeConfig{}) {
+			t.Errorf("Expected zero value, got %+v", got)
+		}
+	})
+
+	t.Run("None layers leave None", func(t *testing.T) {
+		got := Merge(mergeConfig{}, mergeConfig{})
+		if got.Name.IsSome() || got.Database.IsSome() || got.Plugins.IsSome() {
+			t.Errorf("Expected None fields, got %+v", got)
+		}
+	})
+
+	t.Run("layers are not modified", func(t *testing.T) {
+		Merge(defaults, file, flags)
+		if !slices.Equal(defaults.Plugins.OrElse(nil), []string{"core"}) {
+			t.Errorf("Expected defaults.Plugins to be unchanged, got %v", defaults.Plugins)
+		}
+		if len(defaults.Env) != 2 {
+			t.Errorf("Expected defaults.Env to be unchanged, got %v", defaults.Env)
+		}
+	})
+}
+
+type mergeEmbedded struct {
+	A Maybe[int]
+}
+
+func TestMergeEmbeddedUnexported(t *testing.T) {
+	type config struct {
+		mergeEmbedded
+		B Maybe[int]
+	}
+	got, report, err := MergeWith(MergeOptions{}, config{mergeEmbedded{Some(1)}, Some(2)}, config{})
+	if err != nil {
+		t.Fatalf("MergeWith failed: %v", err)
+	}
+	if got.A != Some(1) || got.B != Some(2) {
+		t.Errorf("Expected A Some(1) and B Some(2), got %+v", got)
+	}
+	if report.Layer("A") != Some(0) {
+		t.Errorf("Expected promoted path A from layer 0, got %v", report.Layer("A"))
+	}
+}
+
+func TestMergeOptions(t *testing.T) {
+	type config struct {
+		Tags   []string
+		Extra  []string
+		Labels map[string]int
+	}
+	a := config{Tags: []string{"a"}, Extra: []string{"a"}, Labels: map[string]int{"a": 1}}
+	b := config{Tags: []string{"b"}, Extra: []string{"b"}, Labels: map[string]int{"b": 2}}
+
+	t.Run("defaults", func(t *testing.T) {
+		got, _, err := MergeWith(MergeOptions{Slices: MergeAppend, Maps: MergeKeys}, a, b)
+		if err != nil {
+			t.Fatalf("MergeWith failed: %v", err)
+		}
+		expected := config{Tags: []string{"a", "b"}, Extra: []string{"a", "b"}, Labels: map[string]int{"a": 1, "b": 2}}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, got)
+		}
+	})
+
+	t.Run("strategy hook", func(t *testing.T) {
+		opts := MergeOptions{
+			Slices: MergeAppend,
+			Strategy: func(path string, _ reflect.Type) (MergeStrategy, bool) {
+				return MergeReplace, path == "Extra"
+			},
+		}
+		got, _, err := MergeWith(opts, a, b)
+		if err != nil {
+			t.Fatalf("MergeWith failed: %v", err)
+		}
+		expected := config{Tags: []string{"a", "b"}, Extra: []string{"b"}, Labels: map[string]int{"b": 2}}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, got)
+		}
+	})
+
+	t.Run("invalid strategy for kind", func(t *testing.T) {
+		_, _, err := MergeWith(MergeOptions{Maps: MergeAppend}, a, b)
+		if err == nil || !strings.Contains(err.Error(), "Labels") {
+			t.Errorf("Expected error naming Labels, got %v", err)
+		}
+	})
+}
+
+func TestMergeInvalidTag(t *testing.T) {
+	tests := []struct {
+		name  string
+		merge func()
+	}{
+		{"unknown strategy", func() {
+			Merge(struct {
+				Tags []string `merge:"concat"`
+			}{})
+		}},
+		{"tag on scalar", func() {
+			Merge(struct {
+				Name Maybe[string] `merge:"append"`
+			}{})
+		}},
+		{"merge on slice", func() {
+			Merge(struct {
+				Tags []string `merge:"merge"`
+			}{})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected Merge to panic")
+				}
+			}()
+			tt.merge()
+		})
+	}
+}
@@ -0,0 +1,467 @@
+// Package mergepatch applies and computes JSON Merge Patches (RFC 7386) on
+// typed structs, using maybe.Maybe and maybe.Nullable fields.
+//
+// A patch document can be applied directly to a target struct with Apply:
+//
+//	err := mergepatch.Apply(&user, []byte(`{"name": "bob", "email": null}`))
+//
+// Members set to a value replace the target field (objects are merged
+// recursively, including into the value of a Maybe or Nullable field), members
+// set to null clear the target field (None for Maybe fields, Null for Nullable
+// fields, and the zero value otherwise), and absent members leave the target
+// field alone.
+//
+// Alternatively the document can first be decoded into a patch struct of
+// maybe.Nullable fields, which can be validated before it is applied with
+// ApplyStruct:
+//
+//	type UserPatch struct {
+//		Name  maybe.Nullable[string] `json:"name,omitzero"`
+//		Email maybe.Nullable[string] `json:"email,omitzero"`
+//	}
+//
+// Diff computes the merge patch that turns one value into another.
+package mergepatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+
+	maybe "github.com/zodimo/go-maybe"
+)
+
+var errNotPointer = errors.New("mergepatch: target must be a non-nil pointer")
+
+// Apply applies the merge patch document to the value target points to.
+func Apply(target any, patch []byte) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return errNotPointer
+	}
+	return apply(rv.Elem(), patch)
+}
+
+func apply(dst reflect.Value, patch json.RawMessage) error {
+	members, ok, err := decodeObject(patch)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if merged, err := merge(dst, members); merged || err != nil {
+			return err
+		}
+	}
+	return replace(dst, patch)
+}
+
+// decodeObject decodes patch into its members if it is a JSON object.
+func decodeObject(patch json.RawMessage) (map[string]json.RawMessage, bool, error) {
+	patch = bytes.TrimSpace(patch)
+	if len(patch) == 0 || patch[0] != '{' {
+		return nil, false, nil
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &members); err != nil {
+		return nil, false, err
+	}
+	return members, true, nil
+}
+
+func isNull(raw json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
+
+// replace decodes raw into a fresh value of dst's type and stores it in dst.
+func replace(dst reflect.Value, raw json.RawMessage) error {
+	fresh := reflect.New(dst.Type())
+	if err := json.Unmarshal(raw, fresh.Interface()); err != nil {
+		return err
+	}
+	dst.Set(fresh.Elem())
+	return nil
+}
+
+// merge merges the members of an object patch into dst, reporting whether
+// dst is a kind of value that can be merged.
+func merge(dst reflect.Value, members map[string]json.RawMessage) (bool, error) {
+	switch {
+	case maybe.IsMaybeType(dst.Type()):
+		setter := dst.Addr().Interface().(maybe.AnyMaybeSetter)
+		elem := reflect.New(setter.ElemType()).Elem()
+		if value, ok := setter.AnyValue(); ok && value != nil {
+			elem.Set(reflect.ValueOf(value))
+		}
+		merged, err := merge(elem, members)
+		if !merged || err != nil {
+			return merged, err
+		}
+		return true, setter.SetAny(elem.Interface())
+	case isNullableType(dst.Type()):
+		setter := dst.Addr().Interface().(nullableSetter)
+		elem := reflect.New(setter.ElemType()).Elem()
+		if value, ok := setter.AnyValue(); ok && value != nil {
+			elem.Set(reflect.ValueOf(value))
+		}
+		merged, err := merge(elem, members)
+		if !merged || err != nil {
+			return merged, err
+		}
+		return true, setter.SetAny(elem.Interface())
+	case dst.Kind() == reflect.Pointer:
+		elem := reflect.New(dst.Type().Elem())
+		if !dst.IsNil() {
+			elem.Elem().Set(dst.Elem())
+		}
+		merged, err := merge(elem.Elem(), members)
+		if !merged || err != nil {
+			return merged, err
+		}
+		dst.Set(elem)
+		return true, nil
+	case dst.Kind() == reflect.Struct && hasExportedFields(dst.Type()):
+		return true, mergeStruct(dst, members)
+	case dst.Kind() == reflect.Map && dst.Type().Key().Kind() == reflect.String:
+		return true, mergeMap(dst, members)
+	case dst.Kind() == reflect.Interface && dst.NumMethod() == 0:
+		// A non-object target is replaced by an empty object before merging.
+		object, _ := dst.Interface().(map[string]any)
+		object = maps.Clone(object)
+		if object == nil {
+			object = map[string]any{}
+		}
+		mv := reflect.ValueOf(object)
+		if err := mergeMap(mv, members); err != nil {
+			return true, err
+		}
+		dst.Set(mv)
+		return true, nil
+	}
+	return false, nil
+}
+
+func mergeStruct(dst reflect.Value, members map[string]json.RawMessage) error {
+	fields := jsonFields(dst.Type())
+	for name, raw := range members {
+		index, ok := lookupField(fields, name)
+		if !ok {
+			continue
+		}
+		field, err := fieldByIndex(dst, index)
+		if err != nil {
+			return fmt.Errorf("mergepatch: member %q: %w", name, err)
+		}
+		if isNull(raw) {
+			setNull(field)
+			continue
+		}
+		if err := apply(field, raw); err != nil {
+			return fmt.Errorf("mergepatch: member %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func mergeMap(dst reflect.Value, members map[string]json.RawMessage) error {
+	mapType := dst.Type()
+	if dst.IsNil() {
+		dst.Set(reflect.MakeMap(mapType))
+	}
+	for name, raw := range members {
+		key := reflect.ValueOf(name).Convert(mapType.Key())
+		if isNull(raw) {
+			dst.SetMapIndex(key, reflect.Value{})
+			continue
+		}
+		elem := reflect.New(mapType.Elem()).Elem()
+		if existing := dst.MapIndex(key); existing.IsValid() {
+			elem.Set(existing)
+		}
+		if err := apply(elem, raw); err != nil {
+			return fmt.Errorf("mergepatch: member %q: %w", name, err)
+		}
+		dst.SetMapIndex(key, elem)
+	}
+	return nil
+}
+
+// fieldByIndex returns the nested field of v at index, allocating nil
+// embedded struct pointers along the way like encoding/json.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot set embedded pointer to unexported struct %s", v.Type().Elem())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
+
+// setNull sets v to null: Null for a Nullable, and the zero value (None for a
+// Maybe) otherwise.
+func setNull(v reflect.Value) {
+	if isNullableType(v.Type()) {
+		v.Addr().Interface().(nullableSetter).SetNull()
+		return
+	}
+	v.SetZero()
+}
+
+func hasExportedFields(t reflect.Type) bool {
+	for i := range t.NumField() {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonFields maps the JSON member names of t's fields to their indices.
+func jsonFields(t reflect.Type) map[string][]int {
+	fields := map[string][]int{}
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			t := field.Type
+			if t.Kind() == reflect.Pointer {
+				t = t.Elem()
+			}
+			if t.Kind() == reflect.Struct && !maybe.IsMaybeType(t) {
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if _, ok := fields[name]; !ok {
+			fields[name] = field.Index
+		}
+	}
+	return fields
+}
+
+// lookupField finds a field by exact name, then case-insensitively like encoding/json.
+func lookupField(fields map[string][]int, name string) ([]int, bool) {
+	if index, ok := fields[name]; ok {
+		return index, true
+	}
+	for fieldName, index := range fields {
+		if strings.EqualFold(fieldName, name) {
+			return index, true
+		}
+	}
+	return nil, false
+}
+
+// nullable is implemented by maybe.Nullable.
+type nullable interface {
+	IsAbsent() bool
+	IsNull() bool
+	AnyValue() (any, bool)
+	ElemType() reflect.Type
+}
+
+// nullableSetter is implemented by *maybe.Nullable.
+type nullableSetter interface {
+	nullable
+	SetAny(v any) error
+	SetNull()
+}
+
+var (
+	nullableType       = reflect.TypeFor[nullable]()
+	nullableSetterType = reflect.TypeFor[nullableSetter]()
+)
+
+func isNullableType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.Implements(nullableType) && reflect.PointerTo(t).Implements(nullableSetterType)
+}
+
+// ApplyStruct applies a decoded patch struct to the struct target points to.
+// Patch fields are matched to target fields by Go field name:
+//   - maybe.Nullable fields: Absent leaves the target alone, Null clears it
+//     like a null member in Apply and Value sets it
+//   - maybe.Maybe fields: None leaves the target alone and Some sets it
+//   - struct fields are applied recursively to the matching target field
+//
+// A value is set on a target field of the same type, of a Maybe or Nullable
+// of that type or of a pointer to that type.
+func ApplyStruct(target any, patch any) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return errNotPointer
+	}
+	pv := reflect.ValueOf(patch)
+	for pv.Kind() == reflect.Pointer {
+		if pv.IsNil() {
+			return nil
+		}
+		pv = pv.Elem()
+	}
+	if pv.Kind() != reflect.Struct {
+		return errors.New("mergepatch: patch must be a struct")
+	}
+	return applyStruct(rv.Elem(), pv)
+}
+
+func applyStruct(dst reflect.Value, patch reflect.Value) error {
+	// Patches into a Maybe or pointer apply to the value it holds.
+	switch {
+	case maybe.IsMaybeType(dst.Type()):
+		setter := dst.Addr().Interface().(maybe.AnyMaybeSetter)
+		elem := reflect.New(setter.ElemType()).Elem()
+		if value, ok := setter.AnyValue(); ok && value != nil {
+			elem.Set(reflect.ValueOf(value))
+		}
+		if err := applyStruct(elem, patch); err != nil {
+			return err
+		}
+		return setter.SetAny(elem.Interface())
+	case dst.Kind() == reflect.Pointer:
+		elem := reflect.New(dst.Type().Elem())
+		if !dst.IsNil() {
+			elem.Elem().Set(dst.Elem())
+		}
+		if err := applyStruct(elem.Elem(), patch); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	case dst.Kind() != reflect.Struct:
+		return fmt.Errorf("mergepatch: cannot apply patch %s to %s", patch.Type(), dst.Type())
+	}
+
+	for i := range patch.NumField() {
+		field := patch.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		target := dst.FieldByName(field.Name)
+		if !target.IsValid() {
+			return fmt.Errorf("mergepatch: target %s has no field %s", dst.Type(), field.Name)
+		}
+		pf := patch.Field(i)
+		var err error
+		switch {
+		case field.Type.Implements(nullableType):
+			n := pf.Interface().(nullable)
+			switch {
+			case n.IsAbsent():
+			case n.IsNull():
+				setNull(target)
+			default:
+				value, _ := n.AnyValue()
+				err = set(target, value)
+			}
+		case maybe.IsMaybeType(field.Type):
+			if value, ok := pf.Interface().(maybe.AnyMaybe).AnyValue(); ok {
+				err = set(target, value)
+			}
+		case field.Type.Kind() == reflect.Struct:
+			err = applyStruct(target, pf)
+		default:
+			err = fmt.Errorf("mergepatch: patch field %s has unsupported type %s", field.Name, field.Type)
+		}
+		if err != nil {
+			return fmt.Errorf("mergepatch: field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// set stores value in dst, which is a T, a Maybe[T], a Nullable[T] or a *T.
+func set(dst reflect.Value, value any) error {
+	v := reflect.ValueOf(value)
+	switch {
+	case !v.IsValid():
+		if isNullableType(dst.Type()) {
+			return dst.Addr().Interface().(nullableSetter).SetAny(nil)
+		}
+		if maybe.IsMaybeType(dst.Type()) {
+			return dst.Addr().Interface().(maybe.AnyMaybeSetter).SetAny(nil)
+		}
+		dst.SetZero()
+		return nil
+	case v.Type().AssignableTo(dst.Type()):
+		dst.Set(v)
+		return nil
+	case isNullableType(dst.Type()):
+		return dst.Addr().Interface().(nullableSetter).SetAny(value)
+	case maybe.IsMaybeType(dst.Type()):
+		return dst.Addr().Interface().(maybe.AnyMaybeSetter).SetAny(value)
+	case dst.Kind() == reflect.Pointer && v.Type().AssignableTo(dst.Type().Elem()):
+		ptr := reflect.New(dst.Type().Elem())
+		ptr.Elem().Set(v)
+		dst.Set(ptr)
+		return nil
+	}
+	return fmt.Errorf("cannot set %s from %s", dst.Type(), v.Type())
+}
+
+// Diff returns the merge patch that turns original into modified, comparing
+// their JSON encodings.
+func Diff(original, modified any) ([]byte, error) {
+	a, err := toJSONValue(original)
+	if err != nil {
+		return nil, err
+	}
+	b, err := toJSONValue(modified)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(diff(a, b))
+}
+
+func toJSONValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func diff(a, b any) any {
+	am, aok := a.(map[string]any)
+	bm, bok := b.(map[string]any)
+	if !aok || !bok {
+		return b
+	}
+	patch := map[string]any{}
+	for k, av := range am {
+		bv, ok := bm[k]
+		switch {
+		case !ok || bv == nil:
+			if av != nil {
+				patch[k] = nil
+			}
+		case !reflect.DeepEqual(av, bv):
+			patch[k] = diff(av, bv)
+		}
+	}
+	for k, bv := range bm {
+		if _, ok := am[k]; !ok && bv != nil {
+			patch[k] = bv
+		}
+	}
+	return patch
+}
@@ -0,0 +1,404 @@
+package mergepatch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	maybe "github.com/zodimo/go-maybe"
+)
+
+type address struct {
+	Street string              `json:"street"`
+	City   maybe.Maybe[string] `json:"city,omitzero"`
+}
+
+type user struct {
+	Name    string               `json:"name"`
+	Email   maybe.Maybe[string]  `json:"email,omitzero"`
+	Age     *int                 `json:"age,omitempty"`
+	Tags    []string             `json:"tags,omitempty"`
+	Address maybe.Maybe[address] `json:"address,omitzero"`
+	Labels  map[string]string    `json:"labels,omitempty"`
+	Secret  string               `json:"-"`
+}
+
+type addressPatch struct {
+	Street maybe.Nullable[string] `json:"street,omitzero"`
+	City   maybe.Nullable[string] `json:"city,omitzero"`
+}
+
+type userPatch struct {
+	Name    maybe.Nullable[string] `json:"name,omitzero"`
+	Email   maybe.Nullable[string] `json:"email,omitzero"`
+	Age     maybe.Nullable[int]    `json:"age,omitzero"`
+	Address addressPatch           `json:"address"`
+}
+
+func TestApplyRFC7386(t *testing.T) {
+	// Test vectors from RFC 7386, Appendix A.
+	tests := []struct {
+		original string
+		patch    string
+		expected string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.original+" "+tt.patch, func(t *testing.T) {
+			var target any
+			if err := json.Unmarshal([]byte(tt.original), &target); err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if err := Apply(&target, []byte(tt.patch)); err != nil {
+				t.Fatalf("Apply failed: %v", err)
+			}
+			var expected any
+			if err := json.Unmarshal([]byte(tt.expected), &expected); err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if !reflect.DeepEqual(target, expected) {
+				t.Errorf("Expected %v, got %v", expected, target)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	age := 30
+	original := func() user {
+		return user{
+			Name:    "alice",
+			Email:   maybe.Some("alice@example.com"),
+			Age:     &age,
+			Tags:    []string{"a", "b"},
+			Address: maybe.Some(address{Street: "1 Main", City: maybe.Some("Springfield")}),
+			Labels:  map[string]string{"team": "core", "tier": "gold"},
+			Secret:  "hidden",
+		}
+	}
+
+	t.Run("absent members are left alone", func(t *testing.T) {
+		u := original()
+		if err := Apply(&u, []byte(`{}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if !reflect.DeepEqual(u, original()) {
+			t.Errorf("Expected %+v, got %+v", original(), u)
+		}
+	})
+
+	t.Run("values are set", func(t *testing.T) {
+		u := original()
+		if err := Apply(&u, []byte(`{"name":"bob","email":"bob@example.com","age":41,"tags":["c"]}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if u.Name != "bob" {
+			t.Errorf("Expected name bob, got %q", u.Name)
+		}
+		if u.Email != maybe.Some("bob@example.com") {
+			t.Errorf("Expected email Some(bob@example.com), got %v", u.Email)
+		}
+		if u.Age == nil || *u.Age != 41 {
+			t.Errorf("Expected age 41, got %v", u.Age)
+		}
+		if age != 30 {
+			t.Errorf("Expected original age pointer to be untouched, got %d", age)
+		}
+		if !reflect.DeepEqual(u.Tags, []string{"c"}) {
+			t.Errorf("Expected tags to be replaced, got %v", u.Tags)
+		}
+	})
+
+	t.Run("null clears", func(t *testing.T) {
+		u := original()
+		if err := Apply(&u, []byte(`{"email":null,"age":null,"tags":null,"address":null}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if u.Email.IsSome() || u.Address.IsSome() {
+			t.Errorf("Expected email and address to be None, got %v and %v", u.Email, u.Address)
+		}
+		if u.Age != nil || u.Tags != nil {
+			t.Errorf("Expected age and tags to be cleared, got %v and %v", u.Age, u.Tags)
+		}
+	})
+
+	t.Run("objects are merged", func(t *testing.T) {
+		u := original()
+		if err := Apply(&u, []byte(`{"address":{"city":null},"labels":{"tier":null,"region":"eu"}}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		expected := address{Street: "1 Main"}
+		if u.Address != maybe.Some(expected) {
+			t.Errorf("Expected address %v, got %v", maybe.Some(expected), u.Address)
+		}
+		if !reflect.DeepEqual(u.Labels, map[string]string{"team": "core", "region": "eu"}) {
+			t.Errorf("Expected merged labels, got %v", u.Labels)
+		}
+	})
+
+	t.Run("object into None is Some", func(t *testing.T) {
+		var u user
+		if err := Apply(&u, []byte(`{"address":{"street":"2 High"}}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		expected := maybe.Some(address{Street: "2 High"})
+		if u.Address != expected {
+			t.Errorf("Expected address %v, got %v", expected, u.Address)
+		}
+	})
+
+	t.Run("ignored and unknown members are skipped", func(t *testing.T) {
+		u := original()
+		if err := Apply(&u, []byte(`{"Secret":"x","unknown":1,"NAME":"carol"}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if u.Secret != "hidden" {
+			t.Errorf("Expected secret to be preserved, got %q", u.Secret)
+		}
+		if u.Name != "carol" {
+			t.Errorf("Expected case-insensitive match to set name, got %q", u.Name)
+		}
+	})
+
+	t.Run("type mismatch returns error", func(t *testing.T) {
+		u := original()
+		if err := Apply(&u, []byte(`{"age":"old"}`)); err == nil {
+			t.Error("Expected error when patching a string into an int")
+		}
+	})
+
+	t.Run("non-pointer target returns error", func(t *testing.T) {
+		if err := Apply(original(), []byte(`{}`)); err == nil {
+			t.Error("Expected error for non-pointer target")
+		}
+	})
+}
+
+type Audit struct {
+	UpdatedBy string `json:"updatedBy"`
+}
+
+type profile struct {
+	Nickname maybe.Nullable[string]  `json:"nickname"`
+	Home     maybe.Nullable[address] `json:"home"`
+	Born     time.Time               `json:"born"`
+	*Audit
+}
+
+func TestApplyNullable(t *testing.T) {
+	original := func() profile {
+		return profile{
+			Nickname: maybe.Value("al"),
+			Home:     maybe.Value(address{Street: "s"}),
+		}
+	}
+
+	t.Run("null sets Null", func(t *testing.T) {
+		p := original()
+		if err := Apply(&p, []byte(`{"nickname":null,"home":null}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if !p.Nickname.IsNull() || !p.Home.IsNull() {
+			t.Errorf("Expected Null fields, got %v and %v", p.Nickname, p.Home)
+		}
+	})
+
+	t.Run("value sets Value", func(t *testing.T) {
+		var p profile
+		if err := Apply(&p, []byte(`{"nickname":"bo"}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if p.Nickname != maybe.Value("bo") {
+			t.Errorf("Expected Value(bo), got %v", p.Nickname)
+		}
+	})
+
+	t.Run("objects are merged into the held value", func(t *testing.T) {
+		p := original()
+		if err := Apply(&p, []byte(`{"home":{"city":"c"}}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		expected := maybe.Value(address{Street: "s", City: maybe.Some("c")})
+		if p.Home != expected {
+			t.Errorf("Expected %v, got %v", expected, p.Home)
+		}
+	})
+
+	t.Run("object into Absent is Value", func(t *testing.T) {
+		var p profile
+		if err := Apply(&p, []byte(`{"home":{"street":"t"}}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if p.Home != maybe.Value(address{Street: "t"}) {
+			t.Errorf("Expected Value with street t, got %v", p.Home)
+		}
+	})
+
+	t.Run("struct without exported fields is replaced", func(t *testing.T) {
+		var p profile
+		if err := Apply(&p, []byte(`{"born":"2024-01-02T00:00:00Z"}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		expected := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		if !p.Born.Equal(expected) {
+			t.Errorf("Expected %v, got %v", expected, p.Born)
+		}
+	})
+
+	t.Run("nil embedded pointer is allocated", func(t *testing.T) {
+		var p profile
+		if err := Apply(&p, []byte(`{"updatedBy":"bob"}`)); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if p.Audit == nil || p.UpdatedBy != "bob" {
+			t.Errorf("Expected Audit with UpdatedBy bob, got %+v", p.Audit)
+		}
+	})
+
+	t.Run("ApplyStruct", func(t *testing.T) {
+		p := original()
+		patch := struct {
+			Nickname maybe.Nullable[string]
+			Home     maybe.Nullable[address]
+		}{Nickname: maybe.Null[string](), Home: maybe.Value(address{Street: "u"})}
+		if err := ApplyStruct(&p, patch); err != nil {
+			t.Fatalf("ApplyStruct failed: %v", err)
+		}
+		if !p.Nickname.IsNull() {
+			t.Errorf("Expected Null nickname, got %v", p.Nickname)
+		}
+		if p.Home != maybe.Value(address{Street: "u"}) {
+			t.Errorf("Expected Value with street u, got %v", p.Home)
+		}
+	})
+}
+
+func TestApplyStruct(t *testing.T) {
+	age := 30
+	original := func() user {
+		return user{
+			Name:    "alice",
+			Email:   maybe.Some("alice@example.com"),
+			Age:     &age,
+			Address: maybe.Some(address{Street: "1 Main", City: maybe.Some("Springfield")}),
+		}
+	}
+
+	t.Run("decoded patch is applied", func(t *testing.T) {
+		var patch userPatch
+		if err := json.Unmarshal([]byte(`{"email":null,"age":41,"address":{"city":"Shelbyville"}}`), &patch); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		u := original()
+		if err := ApplyStruct(&u, patch); err != nil {
+			t.Fatalf("ApplyStruct failed: %v", err)
+		}
+		if u.Name != "alice" {
+			t.Errorf("Expected absent name to be left alone, got %q", u.Name)
+		}
+		if u.Email.IsSome() {
+			t.Errorf("Expected null email to be None, got %v", u.Email)
+		}
+		if u.Age == nil || *u.Age != 41 {
+			t.Errorf("Expected age 41, got %v", u.Age)
+		}
+		expected := maybe.Some(address{Street: "1 Main", City: maybe.Some("Shelbyville")})
+		if u.Address != expected {
+			t.Errorf("Expected address %v, got %v", expected, u.Address)
+		}
+	})
+
+	t.Run("Maybe patch fields set on Some", func(t *testing.T) {
+		patch := struct {
+			Name  maybe.Maybe[string]
+			Email maybe.Maybe[string]
+		}{Name: maybe.Some("bob")}
+		u := original()
+		if err := ApplyStruct(&u, &patch); err != nil {
+			t.Fatalf("ApplyStruct failed: %v", err)
+		}
+		if u.Name != "bob" {
+			t.Errorf("Expected name bob, got %q", u.Name)
+		}
+		if u.Email != maybe.Some("alice@example.com") {
+			t.Errorf("Expected None email to be left alone, got %v", u.Email)
+		}
+	})
+
+	t.Run("unknown field returns error", func(t *testing.T) {
+		patch := struct{ Nickname maybe.Nullable[string] }{}
+		u := original()
+		if err := ApplyStruct(&u, patch); err == nil {
+			t.Error("Expected error for field missing from target")
+		}
+	})
+
+	t.Run("mismatched type returns error", func(t *testing.T) {
+		patch := struct{ Name maybe.Nullable[int] }{Name: maybe.Value(1)}
+		u := original()
+		if err := ApplyStruct(&u, patch); err == nil {
+			t.Error("Expected error for mismatched field type")
+		}
+	})
+}
+
+func TestDiff(t *testing.T) {
+	age := 30
+	original := user{
+		Name:    "alice",
+		Email:   maybe.Some("alice@example.com"),
+		Age:     &age,
+		Address: maybe.Some(address{Street: "1 Main", City: maybe.Some("Springfield")}),
+	}
+	modified := user{
+		Name:    "alice",
+		Age:     &age,
+		Tags:    []string{"new"},
+		Address: maybe.Some(address{Street: "1 Main"}),
+	}
+
+	patch, err := Diff(original, modified)
+	if err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+	expected := `{"address":{"city":null},"email":null,"tags":["new"]}`
+	if string(patch) != expected {
+		t.Errorf("Expected %s, got %s", expected, patch)
+	}
+
+	t.Run("applying the diff yields modified", func(t *testing.T) {
+		result := original
+		if err := Apply(&result, patch); err != nil {
+			t.Fatalf("Apply failed: %v", err)
+		}
+		if !reflect.DeepEqual(result, modified) {
+			t.Errorf("Expected %+v, got %+v", modified, result)
+		}
+	})
+
+	t.Run("equal values give an empty patch", func(t *testing.T) {
+		patch, err := Diff(original, original)
+		if err != nil {
+			t.Fatalf("Diff failed: %v", err)
+		}
+		if string(patch) != `{}` {
+			t.Errorf("Expected {}, got %s", patch)
+		}
+	})
+}
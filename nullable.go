@@ -0,0 +1,134 @@
+package maybe
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type nullableState uint8
+
+const (
+	nullableAbsent nullableState = iota
+	nullableNull
+	nullableValue
+)
+
+// Nullable is a tri-state sibling of Maybe that distinguishes a value that
+// was never provided (Absent) from one that was explicitly cleared (Null)
+// and from a present value (Value).
+// The zero value is Absent.
+type Nullable[T any] struct {
+	value T
+	state nullableState
+}
+
+func Absent[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{
+		state: nullableNull,
+	}
+}
+
+func Value[T any](value T) Nullable[T] {
+	return Nullable[T]{
+		value: value,
+		state: nullableValue,
+	}
+}
+
+// FromMaybe converts Some to Value and None to Absent.
+func FromMaybe[T any](m Maybe[T]) Nullable[T] {
+	if m.hasValue {
+		return Value(m.value)
+	}
+	return Absent[T]()
+}
+
+// FromMaybeOrNull converts Some to Value and None to Null.
+func FromMaybeOrNull[T any](m Maybe[T]) Nullable[T] {
+	if m.hasValue {
+		return Value(m.value)
+	}
+	return Null[T]()
+}
+
+func (n Nullable[T]) IsAbsent() bool {
+	return n.state == nullableAbsent
+}
+func (n Nullable[T]) IsNull() bool {
+	return n.state == nullableNull
+}
+func (n Nullable[T]) IsValue() bool {
+	return n.state == nullableValue
+}
+
+// IsPresent returns true if the value was provided, either as null or as a value.
+func (n Nullable[T]) IsPresent() bool {
+	return n.state != nullableAbsent
+}
+
+func (n Nullable[T]) Unwrap() (T, error) {
+	return n.ToMaybe().Unwrap()
+}
+func (n Nullable[T]) OrElse(elseValue T) T {
+	return n.ToMaybe().OrElse(elseValue)
+}
+
+// AnyValue returns the value boxed in an any, and whether it is a Value.
+// Like AnyMaybe.AnyValue, it lets reflection-based code read a Nullable without knowing T.
+func (n Nullable[T]) AnyValue() (any, bool) {
+	if n.state != nullableValue {
+		return nil, false
+	}
+	return n.value, true
+}
+
+// ElemType returns the reflect.Type of T.
+func (n Nullable[T]) ElemType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// SetAny sets the Nullable to Value(v). v must be a T, or nil when T is a nilable type.
+// Like AnyMaybeSetter.SetAny, it lets reflection-based code populate a Nullable without knowing T.
+func (n *Nullable[T]) SetAny(v any) error {
+	var m Maybe[T]
+	if err := m.SetAny(v); err != nil {
+		return fmt.Errorf("maybe: cannot set Nullable[%s] from %T", reflect.TypeFor[T](), v)
+	}
+	*n = Value(m.value)
+	return nil
+}
+
+// SetNull sets the Nullable to Null.
+func (n *Nullable[T]) SetNull() {
+	*n = Null[T]()
+}
+
+// ToMaybe converts Value to Some, and both Absent and Null to None.
+func (n Nullable[T]) ToMaybe() Maybe[T] {
+	if n.state == nullableValue {
+		return Some(n.value)
+	}
+	return None[T]()
+}
+
+// IsZero returns true if Nullable is Absent.
+// Used by encoding/json's 'omitzero' tag to omit missing fields while
+// still writing explicit nulls.
+func (n Nullable[T]) IsZero() bool {
+	return n.state == nullableAbsent
+}
+
+func (n Nullable[T]) String() string {
+	switch n.state {
+	case nullableValue:
+		return fmt.Sprintf("Value[%T](%v)", n.value, n.value)
+	case nullableNull:
+		return fmt.Sprintf("Null[%T]()", n.value)
+	default:
+		return fmt.Sprintf("Absent[%T]()", n.value)
+	}
+}
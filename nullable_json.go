@@ -0,0 +1,33 @@
+//go:build !goexperiment.jsonv2
+
+package maybe
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler interface.
+// Null is written as null; Absent is written as null unless omitted via 'omitzero'.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.state != nullableValue {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+// A JSON null yields Null, any other value yields Value.
+// Fields that are missing from the input are left Absent.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*n = Null[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*n = Value(value)
+	return nil
+}
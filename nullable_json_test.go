@@ -0,0 +1,78 @@
+package maybe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type jsonPatchUser struct {
+	Name     Nullable[string]  `json:"name,omitzero"`
+	Age      Nullable[int]     `json:"age,omitzero"`
+	Nickname Nullable[*string] `json:"nickname,omitzero"`
+}
+
+func TestNullableJSONUnmarshal(t *testing.T) {
+	var u jsonPatchUser
+	if err := json.Unmarshal([]byte(`{"name": "bob", "age": null}`), &u); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := u.Name; got != Value("bob") {
+		t.Errorf("Expected Name Value(bob), got %v", got)
+	}
+	if !u.Age.IsNull() {
+		t.Errorf("Expected Age Null, got %v", u.Age)
+	}
+	if !u.Nickname.IsAbsent() {
+		t.Errorf("Expected Nickname Absent, got %v", u.Nickname)
+	}
+}
+
+func TestNullableJSONUnmarshalPointerNull(t *testing.T) {
+	var u jsonPatchUser
+	if err := json.Unmarshal([]byte(`{"nickname": null}`), &u); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !u.Nickname.IsNull() {
+		t.Errorf("Expected Nickname Null for pointer type, got %v", u.Nickname)
+	}
+}
+
+func TestNullableJSONUnmarshalError(t *testing.T) {
+	var u jsonPatchUser
+	if err := json.Unmarshal([]byte(`{"age": "old"}`), &u); err == nil {
+		t.Error("Expected error when unmarshalling string into Nullable[int]")
+	}
+}
+
+func TestNullableJSONMarshal(t *testing.T) {
+	u := jsonPatchUser{Name: Value("bob"), Age: Null[int]()}
+	data, err := json.Marshal(u)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	expected := `{"name":"bob","age":null}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, string(data))
+	}
+}
+
+func TestNullableJSONRoundTrip(t *testing.T) {
+	inputs := []jsonPatchUser{
+		{},
+		{Name: Null[string](), Age: Value(0)},
+		{Name: Value(""), Age: Absent[int](), Nickname: Null[*string]()},
+	}
+	for _, in := range inputs {
+		data, err := json.Marshal(in)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		var out jsonPatchUser
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if out != in {
+			t.Errorf("Round trip of %s: expected %+v, got %+v", data, in, out)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+//go:build goexperiment.jsonv2
+
+package maybe
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+)
+
+// MarshalJSONTo implements jsontext.MarshalerTo interface.
+// Null is written as null; Absent is written as null unless omitted via 'omitzero'.
+func (n Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if n.state != nullableValue {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return json.MarshalEncode(enc, n.value)
+}
+
+// UnmarshalJSONFrom implements jsontext.UnmarshalerFrom interface.
+// A JSON null yields Null, any other value yields Value.
+// Fields that are missing from the input are left Absent.
+func (n *Nullable[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		*n = Null[T]()
+		return nil
+	}
+	var value T
+	if err := json.UnmarshalDecode(dec, &value); err != nil {
+		return err
+	}
+	*n = Value(value)
+	return nil
+}
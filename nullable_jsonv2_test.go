@@ -0,0 +1,34 @@
+//go:build goexperiment.jsonv2
+
+package maybe
+
+import (
+	"encoding/json/v2"
+	"testing"
+)
+
+func TestNullableJSONv2RoundTrip(t *testing.T) {
+	type patch struct {
+		A Nullable[int] `json:"a,omitzero"`
+		B Nullable[int] `json:"b,omitzero"`
+		C Nullable[int] `json:"c,omitzero"`
+	}
+
+	in := patch{A: Value(1), B: Null[int]()}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	expected := `{"a":1,"b":null}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, string(data))
+	}
+
+	var out patch
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if out != in {
+		t.Errorf("Expected %+v, got %+v", in, out)
+	}
+}
@@ -0,0 +1,145 @@
+package maybe
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNullableStates(t *testing.T) {
+	t.Run("zero value is Absent", func(t *testing.T) {
+		var n Nullable[int]
+		if !n.IsAbsent() || n.IsNull() || n.IsValue() {
+			t.Errorf("Expected zero value to be Absent, got %v", n)
+		}
+		if n.IsPresent() {
+			t.Error("Absent should not be present")
+		}
+	})
+
+	t.Run("Absent", func(t *testing.T) {
+		n := Absent[int]()
+		if !n.IsAbsent() || n.IsNull() || n.IsValue() {
+			t.Errorf("Expected Absent, got %v", n)
+		}
+		if !n.IsZero() {
+			t.Error("Absent should be zero")
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		n := Null[int]()
+		if n.IsAbsent() || !n.IsNull() || n.IsValue() {
+			t.Errorf("Expected Null, got %v", n)
+		}
+		if !n.IsPresent() {
+			t.Error("Null should be present")
+		}
+		if n.IsZero() {
+			t.Error("Null should not be zero")
+		}
+	})
+
+	t.Run("Value", func(t *testing.T) {
+		n := Value(0)
+		if n.IsAbsent() || n.IsNull() || !n.IsValue() {
+			t.Errorf("Expected Value, got %v", n)
+		}
+		if n.IsZero() {
+			t.Error("Value should not be zero")
+		}
+		value, err := n.Unwrap()
+		if err != nil || value != 0 {
+			t.Errorf("Expected 0, got %v (%v)", value, err)
+		}
+	})
+}
+
+func TestNullableUnwrap(t *testing.T) {
+	if _, err := Null[int]().Unwrap(); err == nil {
+		t.Error("Unwrap should return error for Null")
+	}
+	if _, err := Absent[int]().Unwrap(); err == nil {
+		t.Error("Unwrap should return error for Absent")
+	}
+	if got := Null[int]().OrElse(7); got != 7 {
+		t.Errorf("Expected 7, got %v", got)
+	}
+	if got := Value(3).OrElse(7); got != 3 {
+		t.Errorf("Expected 3, got %v", got)
+	}
+}
+
+func TestNullableMaybeConversions(t *testing.T) {
+	t.Run("ToMaybe", func(t *testing.T) {
+		if Absent[int]().ToMaybe().IsSome() {
+			t.Error("Absent should convert to None")
+		}
+		if Null[int]().ToMaybe().IsSome() {
+			t.Error("Null should convert to None")
+		}
+		if got := Value(5).ToMaybe(); got != Some(5) {
+			t.Errorf("Expected Some(5), got %v", got)
+		}
+	})
+
+	t.Run("FromMaybe", func(t *testing.T) {
+		if !FromMaybe(None[int]()).IsAbsent() {
+			t.Error("None should convert to Absent")
+		}
+		if got := FromMaybe(Some(5)); got != Value(5) {
+			t.Errorf("Expected Value(5), got %v", got)
+		}
+	})
+
+	t.Run("FromMaybeOrNull", func(t *testing.T) {
+		if !FromMaybeOrNull(None[int]()).IsNull() {
+			t.Error("None should convert to Null")
+		}
+		if got := FromMaybeOrNull(Some(5)); got != Value(5) {
+			t.Errorf("Expected Value(5), got %v", got)
+		}
+	})
+}
+
+func TestNullableString(t *testing.T) {
+	tests := map[string]Nullable[int]{
+		"Value[int](42)": Value(42),
+		"Null[int]()":    Null[int](),
+		"Absent[int]()":  Absent[int](),
+	}
+	for expected, n := range tests {
+		if n.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, n.String())
+		}
+	}
+}
+
+func TestNullableReflection(t *testing.T) {
+	n := Value(42)
+	if v, ok := n.AnyValue(); !ok || v != 42 {
+		t.Errorf("Expected (42, true), got (%v, %v)", v, ok)
+	}
+	if v, ok := Null[int]().AnyValue(); ok || v != nil {
+		t.Errorf("Expected (nil, false) for Null, got (%v, %v)", v, ok)
+	}
+	if n.ElemType() != reflect.TypeFor[int]() {
+		t.Errorf("Expected int, got %v", n.ElemType())
+	}
+
+	var s Nullable[string]
+	if err := s.SetAny("x"); err != nil || s != Value("x") {
+		t.Errorf("Expected Value(x), got %v (%v)", s, err)
+	}
+	if err := s.SetAny(1); err == nil {
+		t.Error("Expected error setting Nullable[string] from int")
+	}
+	s.SetNull()
+	if !s.IsNull() {
+		t.Errorf("Expected Null, got %v", s)
+	}
+
+	var p Nullable[*int]
+	if err := p.SetAny(nil); err != nil || !p.IsValue() {
+		t.Errorf("Expected Value(nil) for pointer type, got %v (%v)", p, err)
+	}
+}
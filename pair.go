@@ -0,0 +1,43 @@
+package maybe
+
+// Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+func NewPair[A any, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{
+		First:  first,
+		Second: second,
+	}
+}
+
+// Unpack returns both values of the pair.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Tuple3 holds three values of possibly different types.
+type Tuple3[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Tuple4 holds four values of possibly different types.
+type Tuple4[A any, B any, C any, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Tuple5 holds five values of possibly different types.
+type Tuple5[A any, B any, C any, D any, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
@@ -36,3 +36,18 @@ func (m Maybe[T]) ToPtr() *T {
 	val := m.value
 	return &val
 }
+
+// isPointerType reports whether T is a pointer type.
+// reflect.TypeFor resolves to the type descriptor of the instantiation and,
+// unlike reflect.TypeOf(&zero), does not allocate.
+func isPointerType[T any]() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Pointer
+}
+
+// setNull applies the JSON null semantics: null on pointer types is Some(nil)
+// and null on non-pointer types is None.
+func (m *Maybe[T]) setNull() {
+	var zero T
+	m.value = zero
+	m.hasValue = isPointerType[T]()
+}
@@ -0,0 +1,138 @@
+package maybe
+
+import "fmt"
+
+// Result holds either a value (Ok) or an error (Err).
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+func Ok[T any](value T) Result[T] {
+	return Result[T]{
+		value: value,
+	}
+}
+
+// Err creates a failed Result.
+// Err with a nil error is equivalent to Ok of the zero value.
+func Err[T any](err error) Result[T] {
+	return Result[T]{
+		err: err,
+	}
+}
+
+// Try runs f and captures its return values as a Result.
+func Try[T any](f func() (T, error)) Result[T] {
+	value, err := f()
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// OkOr converts Some to Ok and None to Err(err).
+// Like OrElseError, a nil err falls back to ErrNone so that None is never
+// converted to Ok.
+func OkOr[T any](m Maybe[T], err error) Result[T] {
+	if m.hasValue {
+		return Ok(m.value)
+	}
+	if err == nil {
+		err = ErrNone
+	}
+	return Err[T](err)
+}
+
+// FromResult converts Ok to Some and Err to None, discarding the error.
+func FromResult[T any](r Result[T]) Maybe[T] {
+	return r.ToMaybe()
+}
+
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Err returns the error of an Err result, or nil for Ok.
+func (r Result[T]) Err() error {
+	return r.err
+}
+func (r Result[T]) Unwrap() (T, error) {
+	if r.err != nil {
+		var zero T
+		return zero, r.err
+	}
+	return r.value, nil
+}
+func (r Result[T]) UnwrapUnsafe() T {
+	value, err := r.Unwrap()
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func (r Result[T]) Map(f func(T) T) Result[T] {
+	if r.err == nil {
+		return Ok(f(r.value))
+	}
+	return r
+}
+
+func (r Result[T]) FlatMap(f func(T) Result[T]) Result[T] {
+	if r.err == nil {
+		return f(r.value)
+	}
+	return r
+}
+
+func (r Result[T]) OrElse(elseValue T) T {
+	if r.err == nil {
+		return r.value
+	}
+	return elseValue
+}
+func (r Result[T]) OrElseGet(f func(error) T) T {
+	if r.err == nil {
+		return r.value
+	}
+	return f(r.err)
+}
+
+// ToMaybe converts Ok to Some and Err to None, discarding the error.
+func (r Result[T]) ToMaybe() Maybe[T] {
+	if r.err == nil {
+		return Some(r.value)
+	}
+	return None[T]()
+}
+
+func (r Result[T]) String() string {
+	if r.err == nil {
+		return fmt.Sprintf("Ok[%T](%v)", r.value, r.value)
+	}
+	return fmt.Sprintf("Err[%T](%v)", r.value, r.err)
+}
+
+func MapResult[T any, R any](r Result[T], f func(T) R) Result[R] {
+	if r.err == nil {
+		return Ok(f(r.value))
+	}
+	return Err[R](r.err)
+}
+func FlatMapResult[T any, R any](r Result[T], f func(T) Result[R]) Result[R] {
+	if r.err == nil {
+		return f(r.value)
+	}
+	return Err[R](r.err)
+}
+
+func MatchResult[T, U any](r Result[T], onOk func(T) U, onErr func(error) U) U {
+	if r.err == nil {
+		return onOk(r.value)
+	}
+	return onErr(r.err)
+}
@@ -0,0 +1,150 @@
+package maybe
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+func TestResultConstructors(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		r := Ok(42)
+		if !r.IsOk() || r.IsErr() {
+			t.Errorf("Expected Ok, got %v", r)
+		}
+		value, err := r.Unwrap()
+		if err != nil || value != 42 {
+			t.Errorf("Expected 42, got %v (%v)", value, err)
+		}
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		r := Err[int](errTest)
+		if r.IsOk() || !r.IsErr() {
+			t.Errorf("Expected Err, got %v", r)
+		}
+		value, err := r.Unwrap()
+		if !errors.Is(err, errTest) || value != 0 {
+			t.Errorf("Expected zero value and test error, got %v (%v)", value, err)
+		}
+		if !errors.Is(r.Err(), errTest) {
+			t.Errorf("Expected test error, got %v", r.Err())
+		}
+	})
+
+	t.Run("Err with nil error is Ok", func(t *testing.T) {
+		if !Err[int](nil).IsOk() {
+			t.Error("Expected Err(nil) to be Ok")
+		}
+	})
+}
+
+func TestTry(t *testing.T) {
+	if got := Try(func() (int, error) { return strconv.Atoi("12") }); got != Ok(12) {
+		t.Errorf("Expected Ok(12), got %v", got)
+	}
+	if got := Try(func() (int, error) { return strconv.Atoi("x") }); !got.IsErr() {
+		t.Errorf("Expected Err, got %v", got)
+	}
+}
+
+func TestResultUnwrapUnsafe(t *testing.T) {
+	if got := Ok(1).UnwrapUnsafe(); got != 1 {
+		t.Errorf("Expected 1, got %v", got)
+	}
+
+	defer func() {
+		if r := recover(); r != errTest {
+			t.Errorf("Expected panic with test error, got %v", r)
+		}
+	}()
+	Err[int](errTest).UnwrapUnsafe()
+}
+
+func TestResultMap(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+	if got := Ok(5).Map(double); got != Ok(10) {
+		t.Errorf("Expected Ok(10), got %v", got)
+	}
+	if got := Err[int](errTest).Map(double); got.Err() != errTest {
+		t.Errorf("Expected Err to be preserved, got %v", got)
+	}
+	if got := MapResult(Ok(5), strconv.Itoa); got != Ok("5") {
+		t.Errorf("Expected Ok(\"5\"), got %v", got)
+	}
+	if got := MapResult(Err[int](errTest), strconv.Itoa); got.Err() != errTest {
+		t.Errorf("Expected Err to be preserved, got %v", got)
+	}
+}
+
+func TestResultFlatMap(t *testing.T) {
+	parse := func(s string) Result[int] {
+		return Try(func() (int, error) { return strconv.Atoi(s) })
+	}
+	if got := FlatMapResult(Ok("7"), parse); got != Ok(7) {
+		t.Errorf("Expected Ok(7), got %v", got)
+	}
+	if got := FlatMapResult(Ok("x"), parse); !got.IsErr() {
+		t.Errorf("Expected Err, got %v", got)
+	}
+	if got := FlatMapResult(Err[string](errTest), parse); got.Err() != errTest {
+		t.Errorf("Expected Err to be preserved, got %v", got)
+	}
+
+	fail := func(int) Result[int] { return Err[int](errTest) }
+	if got := Ok(1).FlatMap(fail); got.Err() != errTest {
+		t.Errorf("Expected Err, got %v", got)
+	}
+}
+
+func TestMatchResult(t *testing.T) {
+	onOk := func(x int) string { return "ok " + strconv.Itoa(x) }
+	onErr := func(err error) string { return "err " + err.Error() }
+	if got := MatchResult(Ok(1), onOk, onErr); got != "ok 1" {
+		t.Errorf("Expected 'ok 1', got %q", got)
+	}
+	if got := MatchResult(Err[int](errTest), onOk, onErr); got != "err test error" {
+		t.Errorf("Expected 'err test error', got %q", got)
+	}
+}
+
+func TestResultOrElse(t *testing.T) {
+	if got := Ok(1).OrElse(2); got != 1 {
+		t.Errorf("Expected 1, got %v", got)
+	}
+	if got := Err[int](errTest).OrElse(2); got != 2 {
+		t.Errorf("Expected 2, got %v", got)
+	}
+	if got := Err[int](errTest).OrElseGet(func(err error) int { return len(err.Error()) }); got != 10 {
+		t.Errorf("Expected 10, got %v", got)
+	}
+}
+
+func TestResultMaybeConversions(t *testing.T) {
+	if got := Ok(3).ToMaybe(); got != Some(3) {
+		t.Errorf("Expected Some(3), got %v", got)
+	}
+	if got := FromResult(Err[int](errTest)); got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+	if got := OkOr(Some(3), errTest); got != Ok(3) {
+		t.Errorf("Expected Ok(3), got %v", got)
+	}
+	if got := OkOr(None[int](), errTest); got.Err() != errTest {
+		t.Errorf("Expected Err(test error), got %v", got)
+	}
+	if got := OkOr(None[int](), nil); got.Err() != ErrNone {
+		t.Errorf("Expected Err(none) for nil error, got %v", got)
+	}
+}
+
+func TestResultString(t *testing.T) {
+	if got := Ok(1).String(); got != "Ok[int](1)" {
+		t.Errorf("Expected 'Ok[int](1)', got %q", got)
+	}
+	if got := Err[int](errTest).String(); got != "Err[int](test error)" {
+		t.Errorf("Expected 'Err[int](test error)', got %q", got)
+	}
+}
@@ -0,0 +1,192 @@
+// Package sqlpatch builds parameterized SQL clauses from structs of
+// maybe.Maybe fields, writing only the fields that are Some.
+//
+//	type UserPatch struct {
+//		ID    int                 `db:"-"`
+//		Name  maybe.Maybe[string] `db:"name"`
+//		Email maybe.Maybe[string] `db:"email"`
+//	}
+//
+//	clause, args, err := sqlpatch.Set(UserPatch{Name: maybe.Some("bob")}, sqlpatch.Dollar)
+//	// clause: "SET name = $1", args: [Some[string](bob)]
+//
+// Column names come from the `db` tag, or the lower-cased field name when
+// the tag is missing. Fields tagged `db:"-"` are skipped and embedded structs
+// are flattened. Unexported fields are ignored. Any other field that is not
+// a maybe.Maybe is rejected with a *FieldError. Args are the Maybe values
+// themselves, which implement driver.Valuer.
+package sqlpatch
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Style selects how placeholders are written.
+type Style int
+
+const (
+	// Question writes ? placeholders (MySQL, SQLite).
+	Question Style = iota
+	// Dollar writes $1, $2, ... placeholders (PostgreSQL).
+	Dollar
+	// AtName writes @column placeholders and wraps args in sql.Named (SQL Server).
+	AtName
+)
+
+var (
+	ErrNotStruct = errors.New("sqlpatch: value must be a struct or a pointer to a struct")
+	ErrNoFields  = errors.New("sqlpatch: no fields are set")
+)
+
+// FieldError reports a struct field that cannot be written.
+type FieldError struct {
+	Field string
+	Type  reflect.Type
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("sqlpatch: field %s has unsupported type %s, only maybe.Maybe fields are supported (tag it `db:\"-\"` to skip it)", e.Field, e.Type)
+}
+
+// Builder builds clauses with a given placeholder style.
+type Builder struct {
+	Style Style
+	// Offset is added to Dollar placeholder numbers, for statements that
+	// already use parameters before the generated clause.
+	Offset int
+}
+
+// Set builds a "SET col = ?, ..." clause with the default Builder for style.
+func Set(v any, style Style) (string, []any, error) {
+	return Builder{Style: style}.Set(v)
+}
+
+// Insert builds a "(col, ...) VALUES (?, ...)" clause with the default Builder for style.
+func Insert(v any, style Style) (string, []any, error) {
+	return Builder{Style: style}.Insert(v)
+}
+
+// Set builds a "SET col = ?, ..." clause for the Some fields of v.
+func (b Builder) Set(v any) (string, []any, error) {
+	columns, values, err := collect(v)
+	if err != nil {
+		return "", nil, err
+	}
+	var sb strings.Builder
+	sb.WriteString("SET ")
+	for i, column := range columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(column)
+		sb.WriteString(" = ")
+		sb.WriteString(b.placeholder(i, column))
+	}
+	return sb.String(), b.args(columns, values), nil
+}
+
+// Insert builds a "(col, ...) VALUES (?, ...)" clause for the Some fields of v.
+func (b Builder) Insert(v any) (string, []any, error) {
+	columns, values, err := collect(v)
+	if err != nil {
+		return "", nil, err
+	}
+	placeholders := make([]string, len(columns))
+	for i, column := range columns {
+		placeholders[i] = b.placeholder(i, column)
+	}
+	clause := "(" + strings.Join(columns, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ")"
+	return clause, b.args(columns, values), nil
+}
+
+func (b Builder) placeholder(i int, column string) string {
+	switch b.Style {
+	case Dollar:
+		return "$" + strconv.Itoa(b.Offset+i+1)
+	case AtName:
+		return "@" + column
+	default:
+		return "?"
+	}
+}
+
+func (b Builder) args(columns []string, values []any) []any {
+	if b.Style != AtName {
+		return values
+	}
+	args := make([]any, len(values))
+	for i, value := range values {
+		args[i] = sql.Named(columns[i], value)
+	}
+	return args
+}
+
+// optional is implemented by maybe.Maybe.
+type optional interface {
+	IsSome() bool
+	driver.Valuer
+}
+
+func collect(v any) (columns []string, values []any, err error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, nil, ErrNotStruct
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, nil, ErrNotStruct
+	}
+	if err := walk(rv, &columns, &values); err != nil {
+		return nil, nil, err
+	}
+	if len(columns) == 0 {
+		return nil, nil, ErrNoFields
+	}
+	return columns, values, nil
+}
+
+var optionalType = reflect.TypeFor[optional]()
+
+func walk(rv reflect.Value, columns *[]string, values *[]any) error {
+	rt := rv.Type()
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		tag, _, _ := strings.Cut(field.Tag.Get("db"), ",")
+		if tag == "-" {
+			continue
+		}
+		isOptional := field.Type.Kind() == reflect.Struct && field.Type.Implements(optionalType)
+		// Embedded structs are flattened even when their type is unexported,
+		// like encoding/json promotes their exported fields.
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct && !isOptional {
+			if err := walk(rv.Field(i), columns, values); err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if !isOptional {
+			return &FieldError{Field: field.Name, Type: field.Type}
+		}
+		opt := rv.Field(i).Interface().(optional)
+		if !opt.IsSome() {
+			continue
+		}
+		if tag == "" {
+			tag = strings.ToLower(field.Name)
+		}
+		*columns = append(*columns, tag)
+		*values = append(*values, opt)
+	}
+	return nil
+}
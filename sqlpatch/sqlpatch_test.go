@@ -0,0 +1,172 @@
+package sqlpatch
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	maybe "github.com/zodimo/go-maybe"
+)
+
+type Audit struct {
+	UpdatedBy maybe.Maybe[string] `db:"updated_by"`
+}
+
+type userPatch struct {
+	ID       int                    `db:"-"`
+	Name     maybe.Maybe[string]    `db:"name"`
+	Age      maybe.Maybe[int]       `db:"age,omitempty"`
+	Email    maybe.Maybe[string]    `db:"email"`
+	Birthday maybe.Maybe[time.Time] `db:"birthday"`
+	Nickname maybe.Maybe[string]
+	internal int
+	Audit
+}
+
+// values resolves args back to driver values so they can be compared.
+func values(t *testing.T, args []any) []any {
+	t.Helper()
+	out := make([]any, len(args))
+	for i, arg := range args {
+		if named, ok := arg.(sql.NamedArg); ok {
+			arg = named.Value
+		}
+		v, err := arg.(driver.Valuer).Value()
+		if err != nil {
+			t.Fatalf("Value failed: %v", err)
+		}
+		out[i] = v
+	}
+	return out
+}
+
+func TestSet(t *testing.T) {
+	patch := userPatch{
+		ID:       7,
+		Name:     maybe.Some("bob"),
+		Email:    maybe.Some(""),
+		Nickname: maybe.Some("b"),
+		Audit:    Audit{UpdatedBy: maybe.Some("admin")},
+	}
+	tests := []struct {
+		style  Style
+		clause string
+	}{
+		{Question, "SET name = ?, email = ?, nickname = ?, updated_by = ?"},
+		{Dollar, "SET name = $1, email = $2, nickname = $3, updated_by = $4"},
+		{AtName, "SET name = @name, email = @email, nickname = @nickname, updated_by = @updated_by"},
+	}
+	for _, tt := range tests {
+		clause, args, err := Set(&patch, tt.style)
+		if err != nil {
+			t.Fatalf("Set failed: %v", err)
+		}
+		if clause != tt.clause {
+			t.Errorf("Expected %q, got %q", tt.clause, clause)
+		}
+		expected := []any{"bob", "", "b", "admin"}
+		if got := values(t, args); !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected args %v, got %v", expected, got)
+		}
+	}
+}
+
+type audit struct {
+	UpdatedBy maybe.Maybe[string] `db:"updated_by"`
+}
+
+func TestSetEmbeddedUnexported(t *testing.T) {
+	patch := struct {
+		Name maybe.Maybe[string] `db:"name"`
+		audit
+	}{Name: maybe.Some("x"), audit: audit{UpdatedBy: maybe.Some("me")}}
+	clause, args, err := Set(patch, Dollar)
+	if err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if expected := "SET name = $1, updated_by = $2"; clause != expected {
+		t.Errorf("Expected %q, got %q", expected, clause)
+	}
+	if got := values(t, args); !reflect.DeepEqual(got, []any{"x", "me"}) {
+		t.Errorf("Expected args [x me], got %v", got)
+	}
+}
+
+func TestSetNamedArgs(t *testing.T) {
+	_, args, err := Set(userPatch{Age: maybe.Some(3)}, AtName)
+	if err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	named, ok := args[0].(sql.NamedArg)
+	if !ok || named.Name != "age" {
+		t.Errorf("Expected sql.Named(\"age\", ...), got %#v", args[0])
+	}
+}
+
+func TestBuilderOffset(t *testing.T) {
+	clause, _, err := Builder{Style: Dollar, Offset: 1}.Set(userPatch{Name: maybe.Some("bob"), Age: maybe.Some(3)})
+	if err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if expected := "SET name = $2, age = $3"; clause != expected {
+		t.Errorf("Expected %q, got %q", expected, clause)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	clause, args, err := Insert(userPatch{Name: maybe.Some("bob"), Age: maybe.Some(3)}, Dollar)
+	if err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+	if expected := "(name, age) VALUES ($1, $2)"; clause != expected {
+		t.Errorf("Expected %q, got %q", expected, clause)
+	}
+	if got := values(t, args); !reflect.DeepEqual(got, []any{"bob", int64(3)}) {
+		t.Errorf("Expected args [bob 3], got %v", got)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Run("not a struct", func(t *testing.T) {
+		if _, _, err := Set(3, Question); !errors.Is(err, ErrNotStruct) {
+			t.Errorf("Expected ErrNotStruct, got %v", err)
+		}
+		if _, _, err := Set((*userPatch)(nil), Question); !errors.Is(err, ErrNotStruct) {
+			t.Errorf("Expected ErrNotStruct for nil pointer, got %v", err)
+		}
+	})
+
+	t.Run("no fields set", func(t *testing.T) {
+		if _, _, err := Set(userPatch{}, Question); !errors.Is(err, ErrNoFields) {
+			t.Errorf("Expected ErrNoFields, got %v", err)
+		}
+	})
+
+	t.Run("unsupported field type", func(t *testing.T) {
+		type bad struct {
+			Name  maybe.Maybe[string] `db:"name"`
+			Count int                 `db:"count"`
+		}
+		_, _, err := Set(bad{Name: maybe.Some("x")}, Question)
+		var fieldErr *FieldError
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("Expected *FieldError, got %v", err)
+		}
+		if fieldErr.Field != "Count" || fieldErr.Type != reflect.TypeFor[int]() {
+			t.Errorf("Expected Count int, got %s %s", fieldErr.Field, fieldErr.Type)
+		}
+	})
+
+	t.Run("pointer to Maybe is unsupported", func(t *testing.T) {
+		type bad struct {
+			Name *maybe.Maybe[string] `db:"name"`
+		}
+		var fieldErr *FieldError
+		if _, _, err := Set(bad{}, Question); !errors.As(err, &fieldErr) {
+			t.Errorf("Expected *FieldError, got %v", err)
+		}
+	})
+}
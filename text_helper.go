@@ -0,0 +1,47 @@
+package maybe
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/zodimo/go-maybe/internal/textparse"
+)
+
+// marshalText converts value to its text form, using encoding.TextMarshaler
+// when implemented, time.Duration's String, the element of non-nil pointers,
+// and strconv for basic kinds otherwise.
+func marshalText(value any) ([]byte, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, fmt.Errorf("maybe: cannot marshal nil %T as text", value)
+	}
+	if tm, ok := value.(encoding.TextMarshaler); ok {
+		return tm.MarshalText()
+	}
+	if d, ok := value.(time.Duration); ok {
+		return []byte(d.String()), nil
+	}
+	switch rv.Kind() {
+	case reflect.Pointer:
+		return marshalText(rv.Elem().Interface())
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	}
+	return nil, fmt.Errorf("maybe: cannot marshal %T as text", value)
+}
+
+// unmarshalText parses text into the value ptr points to, see textparse.Parse.
+func unmarshalText(text []byte, ptr any) error {
+	return textparse.Parse(reflect.ValueOf(ptr).Elem(), string(text))
+}
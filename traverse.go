@@ -0,0 +1,74 @@
+package maybe
+
+// Sequence returns Some of all values if every element is Some, and None otherwise.
+func Sequence[T any](ms []Maybe[T]) Maybe[[]T] {
+	values := make([]T, 0, len(ms))
+	for _, m := range ms {
+		if !m.hasValue {
+			return None[[]T]()
+		}
+		values = append(values, m.value)
+	}
+	return Some(values)
+}
+
+// Traverse applies f to each element and returns Some of the results if every
+// call returned Some, and None otherwise. It stops at the first None.
+func Traverse[T any, R any](values []T, f func(T) Maybe[R]) Maybe[[]R] {
+	results := make([]R, 0, len(values))
+	for _, value := range values {
+		m := f(value)
+		if !m.hasValue {
+			return None[[]R]()
+		}
+		results = append(results, m.value)
+	}
+	return Some(results)
+}
+
+// CatMaybes returns the values of the Some elements, skipping None.
+func CatMaybes[T any](ms []Maybe[T]) []T {
+	values := make([]T, 0, len(ms))
+	for _, m := range ms {
+		if m.hasValue {
+			values = append(values, m.value)
+		}
+	}
+	return values
+}
+
+// Partition returns the values of the Some elements and the indices of the None elements.
+func Partition[T any](ms []Maybe[T]) (values []T, noneIndices []int) {
+	values = make([]T, 0, len(ms))
+	for i, m := range ms {
+		if m.hasValue {
+			values = append(values, m.value)
+		} else {
+			noneIndices = append(noneIndices, i)
+		}
+	}
+	return values, noneIndices
+}
+
+// SequenceMap returns Some of all values if every entry is Some, and None otherwise.
+func SequenceMap[K comparable, V any](ms map[K]Maybe[V]) Maybe[map[K]V] {
+	values := make(map[K]V, len(ms))
+	for k, m := range ms {
+		if !m.hasValue {
+			return None[map[K]V]()
+		}
+		values[k] = m.value
+	}
+	return Some(values)
+}
+
+// CompactMap returns the entries whose value is Some, skipping None.
+func CompactMap[K comparable, V any](ms map[K]Maybe[V]) map[K]V {
+	values := make(map[K]V, len(ms))
+	for k, m := range ms {
+		if m.hasValue {
+			values[k] = m.value
+		}
+	}
+	return values
+}
@@ -0,0 +1,82 @@
+package maybe
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func parseIntMaybe(s string) Maybe[int] {
+	if n, err := strconv.Atoi(s); err == nil {
+		return Some(n)
+	}
+	return None[int]()
+}
+
+func TestSequence(t *testing.T) {
+	got := Sequence([]Maybe[int]{Some(1), Some(2)})
+	if values, err := got.Unwrap(); err != nil || !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("Expected Some([1 2]), got %v", got)
+	}
+	if got := Sequence([]Maybe[int]{Some(1), None[int]()}); got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+	got = Sequence[int](nil)
+	if values, err := got.Unwrap(); err != nil || values == nil || len(values) != 0 {
+		t.Errorf("Expected Some([]) for empty input, got %v", got)
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	got := Traverse([]string{"1", "2"}, parseIntMaybe)
+	if values, err := got.Unwrap(); err != nil || !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("Expected Some([1 2]), got %v", got)
+	}
+
+	calls := 0
+	got = Traverse([]string{"1", "x", "3"}, func(s string) Maybe[int] {
+		calls++
+		return parseIntMaybe(s)
+	})
+	if got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+	if calls != 2 {
+		t.Errorf("Expected Traverse to stop after the first None, got %d calls", calls)
+	}
+}
+
+func TestCatMaybes(t *testing.T) {
+	got := CatMaybes([]Maybe[int]{None[int](), Some(1), None[int](), Some(3)})
+	if !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	values, noneIndices := Partition([]Maybe[int]{None[int](), Some(1), None[int](), Some(3)})
+	if !slices.Equal(values, []int{1, 3}) {
+		t.Errorf("Expected values [1 3], got %v", values)
+	}
+	if !slices.Equal(noneIndices, []int{0, 2}) {
+		t.Errorf("Expected None indices [0 2], got %v", noneIndices)
+	}
+}
+
+func TestSequenceMap(t *testing.T) {
+	got := SequenceMap(map[string]Maybe[int]{"a": Some(1), "b": Some(2)})
+	if values, err := got.Unwrap(); err != nil || !maps.Equal(values, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("Expected Some(map[a:1 b:2]), got %v", got)
+	}
+	if got := SequenceMap(map[string]Maybe[int]{"a": Some(1), "b": None[int]()}); got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}
+
+func TestCompactMap(t *testing.T) {
+	got := CompactMap(map[string]Maybe[int]{"a": Some(1), "b": None[int]()})
+	if !maps.Equal(got, map[string]int{"a": 1}) {
+		t.Errorf("Expected map[a:1], got %v", got)
+	}
+}
@@ -0,0 +1,81 @@
+package maybe
+
+// Zip combines two Maybes into a Maybe of a Pair, which is Some only when both inputs are Some.
+func Zip[A any, B any](a Maybe[A], b Maybe[B]) Maybe[Pair[A, B]] {
+	if a.hasValue && b.hasValue {
+		return Some(NewPair(a.value, b.value))
+	}
+	return None[Pair[A, B]]()
+}
+
+// Zip3 combines three Maybes into a Maybe of a Tuple3, which is Some only when all inputs are Some.
+func Zip3[A any, B any, C any](a Maybe[A], b Maybe[B], c Maybe[C]) Maybe[Tuple3[A, B, C]] {
+	if a.hasValue && b.hasValue && c.hasValue {
+		return Some(Tuple3[A, B, C]{a.value, b.value, c.value})
+	}
+	return None[Tuple3[A, B, C]]()
+}
+
+// Zip4 combines four Maybes into a Maybe of a Tuple4, which is Some only when all inputs are Some.
+func Zip4[A any, B any, C any, D any](a Maybe[A], b Maybe[B], c Maybe[C], d Maybe[D]) Maybe[Tuple4[A, B, C, D]] {
+	if a.hasValue && b.hasValue && c.hasValue && d.hasValue {
+		return Some(Tuple4[A, B, C, D]{a.value, b.value, c.value, d.value})
+	}
+	return None[Tuple4[A, B, C, D]]()
+}
+
+// Zip5 combines five Maybes into a Maybe of a Tuple5, which is Some only when all inputs are Some.
+func Zip5[A any, B any, C any, D any, E any](a Maybe[A], b Maybe[B], c Maybe[C], d Maybe[D], e Maybe[E]) Maybe[Tuple5[A, B, C, D, E]] {
+	if a.hasValue && b.hasValue && c.hasValue && d.hasValue && e.hasValue {
+		return Some(Tuple5[A, B, C, D, E]{a.value, b.value, c.value, d.value, e.value})
+	}
+	return None[Tuple5[A, B, C, D, E]]()
+}
+
+// Unzip splits a Maybe of a Pair into a Maybe of each element.
+func Unzip[A any, B any](m Maybe[Pair[A, B]]) (Maybe[A], Maybe[B]) {
+	if m.hasValue {
+		return Some(m.value.First), Some(m.value.Second)
+	}
+	return None[A](), None[B]()
+}
+
+// Lift2 turns f into a function over Maybes that returns Some only when all inputs are Some.
+func Lift2[A any, B any, R any](f func(A, B) R) func(Maybe[A], Maybe[B]) Maybe[R] {
+	return func(a Maybe[A], b Maybe[B]) Maybe[R] {
+		if a.hasValue && b.hasValue {
+			return Some(f(a.value, b.value))
+		}
+		return None[R]()
+	}
+}
+
+// Lift3 turns f into a function over Maybes that returns Some only when all inputs are Some.
+func Lift3[A any, B any, C any, R any](f func(A, B, C) R) func(Maybe[A], Maybe[B], Maybe[C]) Maybe[R] {
+	return func(a Maybe[A], b Maybe[B], c Maybe[C]) Maybe[R] {
+		if a.hasValue && b.hasValue && c.hasValue {
+			return Some(f(a.value, b.value, c.value))
+		}
+		return None[R]()
+	}
+}
+
+// Lift4 turns f into a function over Maybes that returns Some only when all inputs are Some.
+func Lift4[A any, B any, C any, D any, R any](f func(A, B, C, D) R) func(Maybe[A], Maybe[B], Maybe[C], Maybe[D]) Maybe[R] {
+	return func(a Maybe[A], b Maybe[B], c Maybe[C], d Maybe[D]) Maybe[R] {
+		if a.hasValue && b.hasValue && c.hasValue && d.hasValue {
+			return Some(f(a.value, b.value, c.value, d.value))
+		}
+		return None[R]()
+	}
+}
+
+// Lift5 turns f into a function over Maybes that returns Some only when all inputs are Some.
+func Lift5[A any, B any, C any, D any, E any, R any](f func(A, B, C, D, E) R) func(Maybe[A], Maybe[B], Maybe[C], Maybe[D], Maybe[E]) Maybe[R] {
+	return func(a Maybe[A], b Maybe[B], c Maybe[C], d Maybe[D], e Maybe[E]) Maybe[R] {
+		if a.hasValue && b.hasValue && c.hasValue && d.hasValue && e.hasValue {
+			return Some(f(a.value, b.value, c.value, d.value, e.value))
+		}
+		return None[R]()
+	}
+}
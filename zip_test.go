@@ -0,0 +1,86 @@
+package maybe
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	if got := Zip(Some(1), Some("a")); got != Some(NewPair(1, "a")) {
+		t.Errorf("Expected Some({1 a}), got %v", got)
+	}
+	if got := Zip(None[int](), Some("a")); got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+	if got := Zip(Some(1), None[string]()); got.IsSome() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}
+
+func TestZipN(t *testing.T) {
+	if got := Zip3(Some(1), Some("a"), Some(true)); got != Some(Tuple3[int, string, bool]{1, "a", true}) {
+		t.Errorf("Zip3: expected Some({1 a true}), got %v", got)
+	}
+	if got := Zip3(Some(1), None[string](), Some(true)); got.IsSome() {
+		t.Errorf("Zip3: expected None, got %v", got)
+	}
+	if got := Zip4(Some(1), Some(2), Some(3), Some(4)); got != Some(Tuple4[int, int, int, int]{1, 2, 3, 4}) {
+		t.Errorf("Zip4: expected Some({1 2 3 4}), got %v", got)
+	}
+	if got := Zip4(Some(1), Some(2), Some(3), None[int]()); got.IsSome() {
+		t.Errorf("Zip4: expected None, got %v", got)
+	}
+	if got := Zip5(Some(1), Some(2), Some(3), Some(4), Some(5)); got != Some(Tuple5[int, int, int, int, int]{1, 2, 3, 4, 5}) {
+		t.Errorf("Zip5: expected Some({1 2 3 4 5}), got %v", got)
+	}
+	if got := Zip5(None[int](), Some(2), Some(3), Some(4), Some(5)); got.IsSome() {
+		t.Errorf("Zip5: expected None, got %v", got)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip(Some(NewPair(1, "a")))
+	if a != Some(1) || b != Some("a") {
+		t.Errorf("Expected Some(1), Some(a), got %v, %v", a, b)
+	}
+	a, b = Unzip(None[Pair[int, string]]())
+	if a.IsSome() || b.IsSome() {
+		t.Errorf("Expected None, None, got %v, %v", a, b)
+	}
+}
+
+func TestLift(t *testing.T) {
+	add := Lift2(func(a, b int) int { return a + b })
+	if got := add(Some(1), Some(2)); got != Some(3) {
+		t.Errorf("Lift2: expected Some(3), got %v", got)
+	}
+	if got := add(Some(1), None[int]()); got.IsSome() {
+		t.Errorf("Lift2: expected None, got %v", got)
+	}
+
+	dsn := Lift3(func(host string, port int, db string) string {
+		return fmt.Sprintf("%s:%d/%s", host, port, db)
+	})
+	if got := dsn(Some("localhost"), Some(5432), Some("app")); got != Some("localhost:5432/app") {
+		t.Errorf("Lift3: expected Some(localhost:5432/app), got %v", got)
+	}
+	if got := dsn(Some("localhost"), None[int](), Some("app")); got.IsSome() {
+		t.Errorf("Lift3: expected None, got %v", got)
+	}
+
+	sum4 := Lift4(func(a, b, c, d int) int { return a + b + c + d })
+	if got := sum4(Some(1), Some(2), Some(3), Some(4)); got != Some(10) {
+		t.Errorf("Lift4: expected Some(10), got %v", got)
+	}
+	if got := sum4(Some(1), Some(2), None[int](), Some(4)); got.IsSome() {
+		t.Errorf("Lift4: expected None, got %v", got)
+	}
+
+	sum5 := Lift5(func(a, b, c, d, e int) int { return a + b + c + d + e })
+	if got := sum5(Some(1), Some(2), Some(3), Some(4), Some(5)); got != Some(15) {
+		t.Errorf("Lift5: expected Some(15), got %v", got)
+	}
+	if got := sum5(Some(1), Some(2), Some(3), Some(4), None[int]()); got.IsSome() {
+		t.Errorf("Lift5: expected None, got %v", got)
+	}
+}